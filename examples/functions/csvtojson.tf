@@ -0,0 +1,35 @@
+# csvtojson function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  # Inventory exported from a spreadsheet
+  inventory = jsondecode(provider::prettyjson::csvtojson(<<-EOT
+    hostname,ip,cores,enabled
+    web-1,10.0.0.1,4,TRUE
+    web-2,10.0.0.2,8,FALSE
+  EOT
+  , { infer_types = true }))
+
+  hosts = { for host in local.inventory : host.hostname => host if host.enabled }
+}
+
+output "host_cores" {
+  value = { for name, host in local.hosts : name => host.cores }
+}
+
+# Semicolon-separated export without a header row
+output "vlans" {
+  value = provider::prettyjson::csvtojson("100;frontend\n200;backend\n", {
+    header    = false
+    columns   = ["id", "name"]
+    delimiter = ";"
+  })
+}
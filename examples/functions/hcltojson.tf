@@ -0,0 +1,27 @@
+# hcltojson function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+# Read literal .tfvars content as JSON, keeping attribute order
+output "tfvars" {
+  value = provider::prettyjson::hcltojson(<<-EOT
+    region = "eu-west-1"
+    tags = {
+      team          = "platform"
+      "cost-center" = 42
+    }
+  EOT
+  )
+}
+
+# Decode the result to use the values in the configuration
+output "region" {
+  value = jsondecode(provider::prettyjson::hcltojson("region = \"eu-west-1\"")).region
+}
@@ -0,0 +1,39 @@
+# jmespath function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  inventory = jsonencode({
+    instances = [
+      { id = "i-1", type = "t3.small", state = "running", tags = { team = "web" } },
+      { id = "i-2", type = "t3.large", state = "stopped", tags = { team = "data" } },
+      { id = "i-3", type = "t3.small", state = "running", tags = { team = "data" } },
+    ]
+  })
+
+  # Projections and filters
+  running_ids = jsondecode(provider::prettyjson::jmespath(local.inventory, "instances[?state == 'running'].id"))
+
+  # Functions and pipes
+  instance_count = jsondecode(provider::prettyjson::jmespath(local.inventory, "length(instances)"))
+}
+
+# Reshape with a multi-select hash
+resource "local_file" "inventory_summary" {
+  content  = provider::prettyjson::jmespath(local.inventory, "instances[*].{id: id, team: tags.team}", "4spaces")
+  filename = "inventory-summary.json"
+}
+
+output "running_ids" {
+  value = local.running_ids
+}
@@ -0,0 +1,52 @@
+# jq function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "environment" {
+  type    = string
+  default = "prod"
+}
+
+locals {
+  services = jsonencode({
+    services = [
+      { name = "api", env = "prod", replicas = 3 },
+      { name = "worker", env = "prod", replicas = 2 },
+      { name = "web", env = "dev", replicas = 1 },
+    ]
+  })
+}
+
+# Existing jq transforms run in-provider; args become jq variables
+resource "local_file" "environment_services" {
+  content = provider::prettyjson::jq(
+    local.services,
+    "[.services[] | select(.env == $env) | {name, replicas}]",
+    { env = var.environment }
+  )
+  filename = "${var.environment}-services.json"
+}
+
+# reduce and string interpolation
+output "replica_summary" {
+  value = jsondecode(provider::prettyjson::jq(
+    local.services,
+    "reduce .services[] as $s ({}; .[$s.env] += $s.replicas) | to_entries | map(\"\\(.key): \\(.value)\")",
+    null
+  ))
+}
+
+# Collect every output of a streaming program into an array
+output "service_names" {
+  value = jsondecode(provider::prettyjson::jq(local.services, ".services[].name", null, { output = "array" }))
+}
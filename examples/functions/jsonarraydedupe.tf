@@ -0,0 +1,33 @@
+# jsonarraydedupe function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  base_statements = [
+    { Sid = "ReadLogs", Effect = "Allow", Action = ["logs:GetLogEvents"], Resource = "*" },
+    { Sid = "WriteLogs", Effect = "Allow", Action = ["logs:PutLogEvents"], Resource = "*" },
+  ]
+  team_statements = [
+    { Sid = "ReadLogs", Effect = "Allow", Action = ["logs:GetLogEvents", "logs:FilterLogEvents"], Resource = "*" },
+  ]
+}
+
+# Drop exact duplicates; key order inside objects does not matter
+output "distinct_ports" {
+  value = provider::prettyjson::jsonarraydedupe(jsonencode([443, 80, 443, 8080, 80]))
+}
+
+# One statement per Sid; later definitions override earlier ones in place
+output "policy_statements" {
+  value = provider::prettyjson::jsonarraydedupe(
+    jsonencode(concat(local.base_statements, local.team_statements)),
+    { key = "Sid", keep = "last" }
+  )
+}
@@ -0,0 +1,31 @@
+# jsonarrayintersect function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  requested_tags = [{ Key = "team", Value = "data" }, { Key = "env", Value = "prod" }, { Key = "owner", Value = "alice" }]
+  allowed_tags   = [{ Value = "prod", Key = "env" }, { Value = "data", Key = "team" }]
+}
+
+# Keep only the tags present in every list
+output "approved_tags" {
+  value = provider::prettyjson::jsonarrayintersect([
+    jsonencode(local.requested_tags),
+    jsonencode(local.allowed_tags),
+  ])
+}
+
+# Compare by tag key only
+output "known_tag_keys" {
+  value = provider::prettyjson::jsonarrayintersect(
+    [jsonencode(local.requested_tags), jsonencode([{ Key = "env" }, { Key = "owner" }])],
+    { key = "Key" }
+  )
+}
@@ -0,0 +1,32 @@
+# jsonarraysubtract function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  desired_members = [{ user = "alice", role = "admin" }, { user = "bob", role = "reader" }, { user = "carol", role = "reader" }]
+  removed_members = [{ user = "bob" }]
+}
+
+# Remove exact matches
+output "remaining_regions" {
+  value = provider::prettyjson::jsonarraysubtract(
+    jsonencode(["us-east-1", "eu-west-1", "ap-south-1"]),
+    jsonencode(["ap-south-1"])
+  )
+}
+
+# Remove members by user name regardless of their other attributes
+output "members" {
+  value = provider::prettyjson::jsonarraysubtract(
+    jsonencode(local.desired_members),
+    jsonencode(local.removed_members),
+    { key = "user" }
+  )
+}
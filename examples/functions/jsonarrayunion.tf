@@ -0,0 +1,37 @@
+# jsonarrayunion function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  shared_rules = [
+    { protocol = "tcp", from_port = 443, to_port = 443, cidr_blocks = ["10.0.0.0/8"] },
+    { protocol = "tcp", from_port = 22, to_port = 22, cidr_blocks = ["10.0.0.0/8"] },
+  ]
+  service_rules = [
+    { protocol = "tcp", from_port = 443, to_port = 443, cidr_blocks = ["10.0.0.0/8"] },
+    { protocol = "tcp", from_port = 8443, to_port = 8443, cidr_blocks = ["10.1.0.0/16"] },
+  ]
+}
+
+# Merge rule sets without repeating identical rules
+output "ingress_rules" {
+  value = provider::prettyjson::jsonarrayunion([
+    jsonencode(local.shared_rules),
+    jsonencode(local.service_rules),
+  ])
+}
+
+# Identify rules by protocol and port range only
+output "ingress_rules_by_port" {
+  value = provider::prettyjson::jsonarrayunion(
+    [jsonencode(local.shared_rules), jsonencode(local.service_rules)],
+    { key = ["protocol", "from_port", "to_port"], indentation_type = "4spaces" }
+  )
+}
@@ -0,0 +1,54 @@
+# jsoncel function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  deployment = jsonencode({
+    metadata = { name = "api-gateway", labels = { team = "platform" } }
+    spec = {
+      replicas = 3
+      containers = [
+        { name = "app", image = "registry.example.com/api:1.4.2" },
+        { name = "sidecar", image = "docker.io/envoyproxy/envoy:v1.30" },
+      ]
+    }
+  })
+}
+
+# Kubernetes-style validation rules evaluate to true or false
+check "deployment_policy" {
+  assert {
+    condition = jsondecode(provider::prettyjson::jsoncel(
+      local.deployment,
+      "self.spec.replicas >= min_replicas && self.spec.containers.all(c, c.image.startsWith('registry.example.com/'))",
+      { variables = { min_replicas = 2 } }
+    ))
+    error_message = "Deployment must run at least 2 replicas from the internal registry."
+  }
+}
+
+# Expressions can also build new documents
+resource "local_file" "images" {
+  content = provider::prettyjson::jsoncel(
+    local.deployment,
+    "{'name': self.metadata.name, 'images': self.spec.containers.map(c, c.image)}"
+  )
+  filename = "images.json"
+}
+
+output "external_images" {
+  value = jsondecode(provider::prettyjson::jsoncel(
+    local.deployment,
+    "self.spec.containers.filter(c, !c.image.startsWith('registry.example.com/')).map(c, c.name)"
+  ))
+}
@@ -0,0 +1,59 @@
+# jsondeepmerge function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  base = jsonencode({
+    replicas = 1
+    containers = [
+      { name = "app", image = "app:1.0", env = { LOG_LEVEL = "info" } },
+      { name = "proxy", image = "envoy:1.29" },
+    ]
+  })
+
+  region = jsonencode({
+    region = "eu-west-1"
+  })
+
+  production = jsonencode({
+    replicas = 3
+    containers = [
+      { name = "app", image = "app:1.1", env = { LOG_LEVEL = "warn" } },
+    ]
+  })
+}
+
+# Merge base, region and environment layers, matching containers by name
+resource "local_file" "deployment" {
+  content = provider::prettyjson::jsondeepmerge(
+    [local.base, local.region, local.production],
+    {
+      array_strategy   = "merge-by-key"
+      merge_key        = "name"
+      on_type_conflict = "error"
+    }
+  )
+  filename = "deployment.json"
+}
+
+# Collect allowed CIDRs from several layers without duplicates
+resource "local_file" "allowlist" {
+  content = provider::prettyjson::jsondeepmerge(
+    [
+      jsonencode({ cidrs = ["10.0.0.0/8"] }),
+      jsonencode({ cidrs = ["10.0.0.0/8", "192.168.0.0/16"] }),
+    ],
+    { array_strategy = "unique-append", indentation_type = "4spaces" }
+  )
+  filename = "allowlist.json"
+}
@@ -0,0 +1,31 @@
+# jsondelete function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  vendor_config = jsonencode({
+    service = "api"
+    debug   = true
+    plugins = ["auth", "metrics", "legacy"]
+  })
+}
+
+# Remove a member and the last array element
+resource "local_file" "production_config" {
+  content = provider::prettyjson::jsondelete(
+    provider::prettyjson::jsondelete(local.vendor_config, "/debug"),
+    "/plugins/-",
+    { indentation_type = "tab" }
+  )
+  filename = "production-config.json"
+}
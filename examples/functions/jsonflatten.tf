@@ -0,0 +1,37 @@
+# jsonflatten function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  app_config = jsonencode({
+    database = {
+      hosts = ["db-1.internal", "db-2.internal"]
+      port  = 5432
+    }
+    features = { "beta.checkout" = true }
+  })
+}
+
+# One SSM parameter per leaf: /myapp/database/hosts[0], /myapp/database/port, ...
+resource "aws_ssm_parameter" "app_config" {
+  for_each = provider::prettyjson::jsonflatten(local.app_config, "/")
+
+  name  = "/myapp/${each.key}"
+  type  = "String"
+  value = each.value
+}
+
+# Keys containing the separator are escaped: features.beta\.checkout
+output "dotted_config" {
+  value = provider::prettyjson::jsonflatten(local.app_config, ".")
+}
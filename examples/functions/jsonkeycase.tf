@@ -0,0 +1,37 @@
+# jsonkeycase function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  service = {
+    service_name  = "checkout"
+    health_check  = { callback_url = "https://checkout.internal/health", interval_seconds = 30 }
+    owner_user_id = "u-123"
+    tags          = { cost_center = "42", "team-name" = "payments" }
+  }
+}
+
+# Terraform-style snake_case to the camelCase an API expects. The keys of
+# the user-defined tags map are left alone.
+resource "local_file" "api_payload" {
+  content = provider::prettyjson::jsonkeycase(jsonencode(local.service), "camel", {
+    exclude  = ["tags"]
+    acronyms = ["ID", "URL"]
+  })
+  filename = "payload.json"
+}
+
+# And back again for an API response
+output "snake_case_response" {
+  value = provider::prettyjson::jsonkeycase("{\"serviceName\":\"checkout\",\"ownerUserID\":\"u-123\"}", "snake")
+}
@@ -0,0 +1,53 @@
+# jsonmergepatch function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  base_config = jsonencode({
+    service  = "api"
+    replicas = 1
+    debug    = true
+    resources = {
+      limits = {
+        cpu    = "1"
+        memory = "1Gi"
+      }
+    }
+  })
+
+  # null removes a key, objects merge recursively, arrays replace
+  production_overrides = jsonencode({
+    replicas = 3
+    debug    = null
+    resources = {
+      limits = {
+        cpu = "2"
+      }
+    }
+  })
+}
+
+resource "local_file" "production_config" {
+  content  = provider::prettyjson::jsonmergepatch(local.base_config, local.production_overrides)
+  filename = "production-config.json"
+}
+
+# Layer several patches by nesting calls
+resource "local_file" "regional_config" {
+  content = provider::prettyjson::jsonmergepatch(
+    provider::prettyjson::jsonmergepatch(local.base_config, local.production_overrides),
+    jsonencode({ region = "eu-west-1" }),
+    "4spaces"
+  )
+  filename = "regional-config.json"
+}
@@ -0,0 +1,30 @@
+# jsonomit function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "exported_deployment" {
+  type        = string
+  description = "Deployment manifest exported from a cluster, as JSON"
+}
+
+# Strip server-managed fields before committing an exported resource
+resource "local_file" "clean_manifest" {
+  content = provider::prettyjson::jsonomit(var.exported_deployment, [
+    "status",
+    "metadata.managedFields",
+    "metadata.resourceVersion",
+    "/metadata/uid",
+    "**.creationTimestamp",
+  ])
+  filename = "manifests/deployment.json"
+}
@@ -0,0 +1,41 @@
+# jsonpatch function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  base_config = jsonencode({
+    service  = "api"
+    replicas = 1
+    debug    = true
+    features = ["auth"]
+  })
+
+  production_patch = jsonencode([
+    { op = "test", path = "/service", value = "api" },
+    { op = "replace", path = "/replicas", value = 3 },
+    { op = "remove", path = "/debug" },
+    { op = "add", path = "/features/-", value = "metrics" },
+  ])
+}
+
+# Layer an environment-specific patch over a base document
+resource "local_file" "production_config" {
+  content  = provider::prettyjson::jsonpatch(local.base_config, local.production_patch)
+  filename = "production-config.json"
+}
+
+# Patched output formatted with 4-space indentation
+resource "local_file" "production_config_4spaces" {
+  content  = provider::prettyjson::jsonpatch(local.base_config, local.production_patch, "4spaces")
+  filename = "production-config-4spaces.json"
+}
@@ -0,0 +1,43 @@
+# jsonpath function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  manifest = jsonencode({
+    spec = {
+      containers = [
+        { name = "app", image = "nginx:1.27", enabled = true },
+        { name = "sidecar", image = "envoy:1.30", enabled = false },
+      ]
+      initContainers = [
+        { name = "init", image = "busybox:1.36", enabled = true },
+      ]
+    }
+  })
+
+  # Every image anywhere in the manifest
+  all_images = jsondecode(provider::prettyjson::jsonpath(local.manifest, "$..image"))
+
+  # Filters, slices and function extensions
+  enabled_containers = provider::prettyjson::jsonpath(local.manifest, "$.spec.containers[?@.enabled == true]")
+  nginx_names        = provider::prettyjson::jsonpath(local.manifest, "$..[?match(@.image, 'nginx:.*')].name")
+}
+
+resource "local_file" "enabled_containers" {
+  content  = local.enabled_containers
+  filename = "enabled-containers.json"
+}
+
+output "all_images" {
+  value = local.all_images
+}
@@ -0,0 +1,45 @@
+# jsonpick function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  user_profile = jsonencode({
+    id    = "u-123"
+    name  = "Alex"
+    email = "alex@example.com"
+    address = {
+      city    = "Berlin"
+      street  = "Example Str. 1"
+      country = "DE"
+    }
+    devices = [
+      { id = "d1", model = "laptop", serial = "ABC" },
+      { id = "d2", model = "phone", serial = "DEF" },
+    ]
+  })
+}
+
+# Send only an allowlisted subset to a downstream API
+resource "local_file" "public_profile" {
+  content = provider::prettyjson::jsonpick(local.user_profile, [
+    "/id",
+    "name",
+    "address.city",
+    "devices[*].model",
+  ])
+  filename = "public-profile.json"
+}
+
+output "device_ids" {
+  value = provider::prettyjson::jsonpick(local.user_profile, ["devices[*].id"], { indentation_type = "4spaces" })
+}
@@ -0,0 +1,48 @@
+# jsonpointer function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  # A vendor-supplied document with a mixed-type array and a number larger
+  # than jsondecode() can round-trip exactly
+  vendor_config = jsonencode({
+    spec = {
+      image  = "nginx:1.27"
+      ports  = [80, 443]
+      limits = { memory = "1Gi", cpu = "2" }
+    }
+    matrix = ["linux", 64, true]
+  })
+
+  # Returned as JSON: "nginx:1.27" (quoted)
+  image_json = provider::prettyjson::jsonpointer(local.vendor_config, "/spec/image")
+
+  # Returned as a Terraform value: nginx:1.27
+  image = provider::prettyjson::jsonpointer(local.vendor_config, "/spec/image", { output = "value" })
+
+  # Missing pointers fall back to the default instead of failing
+  replicas = provider::prettyjson::jsonpointer(local.vendor_config, "/spec/replicas", 1, { output = "value" })
+}
+
+resource "local_file" "limits" {
+  content  = provider::prettyjson::jsonpointer(local.vendor_config, "/spec/limits", { indentation_type = "4spaces" })
+  filename = "limits.json"
+}
+
+output "image" {
+  value = local.image
+}
+
+output "replicas" {
+  value = local.replicas
+}
@@ -91,4 +91,12 @@ resource "local_file" "complex_example" {
     "2spaces"
   )
   filename = "complex-config.json"
-}
\ No newline at end of file
+}
+# Normalize a file owned by another team without imposing an indentation
+# style; minified input falls back to tabs
+output "preserved_style" {
+  value = provider::prettyjson::jsonprettyprint(
+    "{\n   \"name\": \"shared\", \"ports\": [80,443]\n}",
+    { indentation_type = "preserve", indentation_fallback = "tab" }
+  )
+}
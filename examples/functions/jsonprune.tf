@@ -0,0 +1,49 @@
+# jsonprune function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "service" {
+  type = object({
+    name        = string
+    description = optional(string)
+    replicas    = optional(number)
+    labels      = optional(map(string), {})
+    tags        = optional(map(string), {})
+    endpoints   = optional(list(string), [])
+  })
+  default = {
+    name = "web"
+  }
+}
+
+# jsonencode emits null for every unset optional attribute; drop them
+resource "local_file" "service" {
+  content  = provider::prettyjson::jsonprune(jsonencode(var.service))
+  filename = "service.json"
+}
+
+# Also drop empty strings, but always send tags even when empty
+output "service_request" {
+  value = provider::prettyjson::jsonprune(jsonencode(var.service), {
+    empty_strings    = true
+    exclude          = ["tags"]
+    indentation_type = "4spaces"
+  })
+}
+
+# Only remove nulls; empty containers are kept
+output "nulls_only" {
+  value = provider::prettyjson::jsonprettyprint(jsonencode(var.service), {
+    prune = { empty_objects = false, empty_arrays = false }
+  })
+}
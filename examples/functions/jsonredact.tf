@@ -0,0 +1,53 @@
+# jsonredact function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  app_config = jsonencode({
+    database = {
+      host     = "db.internal"
+      password = "hunter2"
+    }
+    integrations = [
+      { name = "github", api_token = "ghp_example" },
+      { name = "slack", webhook_url = "https://hooks.slack.com/services/example" },
+    ]
+  })
+}
+
+# Mask every member whose name looks sensitive
+resource "local_file" "sanitized_config" {
+  content = provider::prettyjson::jsonredact(local.app_config, {
+    keys     = ["(?i)password|secret|token"]
+    pointers = ["/database/host"]
+  })
+  filename = "config.sanitized.json"
+}
+
+# Keep the shape of the document for schema validation
+output "placeholder_config" {
+  value = provider::prettyjson::jsonredact(
+    local.app_config,
+    { paths = ["$.integrations[*].webhook_url"] },
+    { mode = "placeholder", mask = "<redacted>" }
+  )
+}
+
+# Hashes reveal when a secret changes without revealing the secret
+output "hashed_config" {
+  value = provider::prettyjson::jsonredact(
+    local.app_config,
+    { keys = ["(?i)password|token"] },
+    { mode = "hash", salt = "example-salt" }
+  )
+}
@@ -0,0 +1,54 @@
+# jsonreplace function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "account_id" {
+  type    = string
+  default = "222222222222"
+}
+
+locals {
+  # Vendor-provided policy template with a placeholder account
+  vendor_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject"]
+      Resource = ["arn:aws:s3:::vendor-bucket/*"]
+      Principal = {
+        AWS = "arn:aws:iam::111111111111:role/vendor-reader"
+      }
+    }]
+  })
+}
+
+# Swap the account ID in every principal ARN; keys and other values stay untouched
+resource "local_file" "policy" {
+  content = provider::prettyjson::jsonreplace(
+    local.vendor_policy,
+    "$.Statement[*].Principal.AWS",
+    "^arn:aws:iam::[0-9]{12}:",
+    "arn:aws:iam::${var.account_id}:"
+  )
+  filename = "policy.json"
+}
+
+# Capture groups: "$1" works directly, "${name}" must be written as "$${name}"
+output "renamed_buckets" {
+  value = provider::prettyjson::jsonreplace(
+    local.vendor_policy,
+    "Statement[*].Resource",
+    "^arn:aws:s3:::(?P<bucket>[a-z0-9-]+)/",
+    "arn:aws:s3:::$${bucket}-copy/"
+  )
+}
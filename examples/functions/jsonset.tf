@@ -0,0 +1,36 @@
+# jsonset function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  # Stands in for a vendor-supplied document whose key order and number
+  # literals should survive the edit
+  vendor_config = "{\"spec\":{\"replicas\":1,\"ports\":[8080],\"cpu\":0.50}}"
+}
+
+# Replace a single field
+resource "local_file" "scaled_config" {
+  content  = provider::prettyjson::jsonset(local.vendor_config, "/spec/replicas", "3")
+  filename = "scaled-config.json"
+}
+
+# Append to an array and create missing parents on the way
+resource "local_file" "labelled_config" {
+  content = provider::prettyjson::jsonset(
+    provider::prettyjson::jsonset(local.vendor_config, "/spec/ports/-", "8443"),
+    "/metadata/labels/team",
+    jsonencode("platform"),
+    { create_parents = true, indentation_type = "4spaces" }
+  )
+  filename = "labelled-config.json"
+}
@@ -0,0 +1,56 @@
+# jsonsortarrays function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  # Policy as returned by an API, with actions in arbitrary order
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      { Sid = "Write", Effect = "Allow", Action = ["s3:PutObject", "s3:DeleteObject"], Resource = "*" },
+      { Sid = "Read", Effect = "Allow", Action = ["s3:ListBucket", "s3:GetObject"], Resource = "*" },
+    ]
+  })
+
+  rules = jsonencode([
+    { protocol = "udp", from_port = 53, to_port = 53 },
+    { protocol = "tcp", from_port = 22, to_port = 22 },
+    { protocol = "tcp", from_port = 443, to_port = 443 },
+  ])
+}
+
+# Sort the actions of every statement, and the statements by Sid
+resource "local_file" "policy" {
+  content = provider::prettyjson::jsonsortarrays(
+    local.policy,
+    ["Statement[*].Action", { path = "Statement", by = "Sid" }]
+  )
+  filename = "policy.json"
+}
+
+# Sort objects by several keys; later keys break ties
+output "rules_by_protocol_and_port" {
+  value = provider::prettyjson::jsonsortarrays(
+    local.rules,
+    { path = "", by = ["protocol", { key = "from_port", order = "desc" }] },
+    { indentation_type = "4spaces" }
+  )
+}
+
+# The same rules are available when pretty-printing
+output "pretty_policy" {
+  value = provider::prettyjson::jsonprettyprint(local.policy, {
+    indentation_type = "tab"
+    sort_arrays      = ["**"]
+  })
+}
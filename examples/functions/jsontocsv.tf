@@ -0,0 +1,38 @@
+# jsontocsv function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "hosts" {
+  type = list(object({
+    hostname = string
+    ip       = string
+    tags     = map(string)
+  }))
+  default = [
+    { hostname = "web-1", ip = "10.0.0.1", tags = { env = "prod", team = "web" } },
+    { hostname = "db-1", ip = "10.0.0.2", tags = { env = "prod", team = "data" } },
+  ]
+}
+
+# Nested tags become tags.env and tags.team columns
+resource "local_file" "inventory" {
+  content  = provider::prettyjson::jsontocsv(jsonencode(var.hosts))
+  filename = "inventory.csv"
+}
+
+# Pick and order the columns of a report
+output "report" {
+  value = provider::prettyjson::jsontocsv(jsonencode(var.hosts), {
+    columns = ["tags.team", "hostname", "ip"]
+  })
+}
@@ -0,0 +1,36 @@
+# jsontoenv function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  app_settings = {
+    database = { host = "db.internal", port = 5432 }
+    greeting = "Hello, \"world\"\nWelcome back"
+    features = ["search", "billing"]
+  }
+}
+
+# APP_DATABASE_HOST=db.internal, APP_FEATURES_0=search, and a quoted,
+# escaped APP_GREETING
+resource "local_file" "env" {
+  content = provider::prettyjson::jsontoenv(jsonencode(local.app_settings), {
+    prefix    = "APP_"
+    uppercase = true
+  })
+  filename = "app.env"
+}
+
+# A file that can be sourced by a shell
+output "shell_env" {
+  value = provider::prettyjson::jsontoenv(jsonencode({ path = "/opt/app/bin" }), { export = true })
+}
@@ -0,0 +1,34 @@
+# jsontohcl function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+locals {
+  workspace_inputs = {
+    region         = "eu-west-1"
+    instance_count = 3
+    tags           = { team = "platform", "cost-center" = "42" }
+    user_data      = "#!/bin/sh\necho ready\n"
+  }
+}
+
+# A .tfvars file for a downstream workspace; the multi-line user_data
+# becomes a heredoc
+resource "local_file" "tfvars" {
+  content  = provider::prettyjson::jsontohcl(jsonencode(local.workspace_inputs))
+  filename = "downstream.auto.tfvars"
+}
+
+# Quote all strings instead of using heredocs
+output "inputs" {
+  value = provider::prettyjson::jsontohcl(jsonencode(local.workspace_inputs), { heredocs = false })
+}
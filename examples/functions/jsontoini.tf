@@ -0,0 +1,29 @@
+# jsontoini function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+# Top-level values become global keys and objects become sections;
+# nested objects are flattened into keys such as pool.size
+resource "local_file" "config" {
+  content = provider::prettyjson::jsontoini(jsonencode({
+    name     = "billing"
+    database = { host = "db.internal", pool = { size = 10 } }
+    server   = { banner = "Welcome; \"guest\"" }
+  }))
+  filename = "app.ini"
+}
+
+# Reject arrays, which have no standard INI representation
+output "strict" {
+  value = provider::prettyjson::jsontoini(jsonencode({ logging = { level = "info" } }), { arrays = "reject" })
+}
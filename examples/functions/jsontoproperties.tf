@@ -0,0 +1,30 @@
+# jsontoproperties function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+# Spring Boot configuration with indexed list keys such as
+# spring.profiles.active[0]
+resource "local_file" "application" {
+  content = provider::prettyjson::jsontoproperties(jsonencode({
+    spring = {
+      datasource = { url = "jdbc:postgresql://db.internal:5432/app" }
+      profiles   = { active = ["prod", "metrics"] }
+    }
+  }))
+  filename = "application.properties"
+}
+
+# Keep non-ASCII characters as UTF-8 for Properties.load(Reader)
+output "messages" {
+  value = provider::prettyjson::jsontoproperties(jsonencode({ greeting = "Grüße" }), { ascii = false })
+}
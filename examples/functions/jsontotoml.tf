@@ -0,0 +1,44 @@
+# jsontotoml function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "hostname" {
+  type    = string
+  default = null
+}
+
+# Generate a Telegraf configuration; unset optional values are pruned first
+# because TOML has no null
+resource "local_file" "telegraf" {
+  content = provider::prettyjson::jsontotoml(provider::prettyjson::jsonprune(jsonencode({
+    agent = {
+      interval = "10s"
+      hostname = var.hostname
+    }
+    inputs = {
+      cpu  = [{ percpu = true, totalcpu = true }]
+      disk = [{ ignore_fs = ["tmpfs", "devtmpfs"] }]
+    }
+    outputs = {
+      influxdb_v2 = [{ urls = ["http://influxdb:8086"], bucket = "metrics" }]
+    }
+  })))
+  filename = "telegraf.conf"
+}
+
+# Write a datetime rather than a string
+output "release" {
+  value = provider::prettyjson::jsontotoml(jsonencode({
+    released = { type = "datetime", value = "2024-03-01T12:00:00Z" }
+  }), { tag_datetimes = true })
+}
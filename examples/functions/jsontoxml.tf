@@ -0,0 +1,48 @@
+# jsontoxml function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "servers" {
+  type = list(object({
+    id      = string
+    address = string
+  }))
+  default = [
+    { id = "primary", address = "10.0.0.10" },
+    { id = "secondary", address = "10.0.0.11" },
+  ]
+}
+
+# Render an appliance configuration; "@" members become attributes and
+# lists become repeated elements
+resource "local_file" "appliance" {
+  content = provider::prettyjson::jsontoxml(jsonencode({
+    appliance = {
+      "@version" = 3
+      server = [for s in var.servers : {
+        "@id"   = s.id
+        address = s.address
+      }]
+    }
+  }))
+  filename = "appliance.xml"
+}
+
+# Wrap a request body in a namespaced root element
+output "soap_body" {
+  value = provider::prettyjson::jsontoxml(jsonencode({ GetStatus = { Device = "edge-01" } }), {
+    root        = "soap:Body"
+    xmlns       = { soap = "http://schemas.xmlsoap.org/soap/envelope/" }
+    declaration = false
+  })
+}
@@ -0,0 +1,31 @@
+# jsontoyaml function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+# Write pipeline JSON back as a Helm values file; "no" is quoted
+resource "local_file" "values" {
+  content = provider::prettyjson::jsontoyaml(jsonencode({
+    image    = { repository = "nginx", tag = "1.27" }
+    replicas = 2
+    country  = "no"
+  }), { indent = 4 })
+  filename = "values.yaml"
+}
+
+# One YAML document per array element
+output "manifest_stream" {
+  value = provider::prettyjson::jsontoyaml(jsonencode([
+    { kind = "Namespace", metadata = { name = "web" } },
+    { kind = "ServiceAccount", metadata = { name = "web", namespace = "web" } },
+  ]), { multi_document = true })
+}
@@ -0,0 +1,38 @@
+# jsonunflatten function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+variable "settings" {
+  type = map(string)
+  default = {
+    "server.port"         = "8080"
+    "server.hosts[0]"     = "a.example.com"
+    "server.hosts[1]"     = "b.example.com"
+    "logging.level"       = "info"
+    "logging.json_format" = "true"
+  }
+}
+
+# Rebuild a nested document; infer_types turns "8080" and "true" into JSON values
+resource "local_file" "settings" {
+  content  = provider::prettyjson::jsonunflatten(var.settings, ".", { infer_types = true })
+  filename = "settings.json"
+}
+
+# Round trip through jsonflatten with a different separator
+output "env_style" {
+  value = provider::prettyjson::jsonflatten(
+    provider::prettyjson::jsonunflatten(var.settings, "."),
+    "__"
+  )
+}
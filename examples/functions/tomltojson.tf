@@ -0,0 +1,35 @@
+# tomltojson function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+# Read a Cargo manifest; key order and arrays of tables are kept
+output "cargo_manifest" {
+  value = provider::prettyjson::tomltojson(<<-EOT
+    [package]
+    name = "billing"
+    version = "0.4.1"
+    edition = "2021"
+
+    [dependencies]
+    serde = { version = "1", features = ["derive"] }
+
+    [[bin]]
+    name = "billing-api"
+    path = "src/main.rs"
+  EOT
+  )
+}
+
+# Keep datetimes distinguishable from strings
+output "release" {
+  value = provider::prettyjson::tomltojson("released = 2024-03-01T12:00:00Z\n", {
+    tag_datetimes = true
+  })
+}
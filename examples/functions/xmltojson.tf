@@ -0,0 +1,35 @@
+# xmltojson function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+# Read an appliance configuration; "server" is always a list, even when
+# the appliance has a single server
+output "appliance" {
+  value = provider::prettyjson::xmltojson(<<-EOT
+    <appliance version="3">
+      <server id="primary">
+        <address>10.0.0.10</address>
+      </server>
+    </appliance>
+  EOT
+  , { always_array = ["server"] })
+}
+
+# Drop SOAP namespace prefixes to get plain member names
+output "soap_response" {
+  value = provider::prettyjson::xmltojson(<<-EOT
+    <soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+      <soap:Body>
+        <GetStatusResponse><Status>OK</Status></GetStatusResponse>
+      </soap:Body>
+    </soap:Envelope>
+  EOT
+  , { namespaces = "strip" })
+}
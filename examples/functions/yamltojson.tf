@@ -0,0 +1,35 @@
+# yamltojson function examples
+
+terraform {
+  required_providers {
+    prettyjson = {
+      source = "graysievert/prettyjson"
+    }
+  }
+  required_version = ">= 1.8.0"
+}
+
+# Helm values keep their key order; anchors and merge keys are expanded,
+# and "no" stays a string under the YAML 1.2 core schema
+output "helm_values" {
+  value = provider::prettyjson::yamltojson(<<-EOT
+    resources: &resources
+      cpu: 100m
+      memory: 128Mi
+    web:
+      <<: *resources
+      memory: 256Mi
+    country: no
+  EOT
+  )
+}
+
+# A multi-document manifest stream always becomes a JSON array
+output "manifests" {
+  value = provider::prettyjson::yamltojson(<<-EOT
+    kind: Namespace
+    ---
+    kind: ServiceAccount
+  EOT
+  , { always_array = true, indentation_type = "4spaces" })
+}
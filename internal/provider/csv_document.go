@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Default CSV dialect.
+const (
+	DefaultCSVDelimiter = ","
+	DefaultCSVQuote     = `"`
+)
+
+// CSVHeaderAuto detects whether the first row of a CSV document is a header.
+const CSVHeaderAuto = "auto"
+
+// csvDialect is the delimiter and quote character of a CSV document. A zero
+// quote disables quoting.
+type csvDialect struct {
+	delimiter rune
+	quote     rune
+}
+
+// parseCSVDialect reads the delimiter and quote options.
+func parseCSVDialect(opts functionOptions) (csvDialect, *function.FuncError) {
+	var dialect csvDialect
+
+	delimiter, funcErr := opts.stringOption("delimiter", DefaultCSVDelimiter)
+	if funcErr != nil {
+		return dialect, funcErr
+	}
+	quote, funcErr := opts.stringOption("quote", DefaultCSVQuote)
+	if funcErr != nil {
+		return dialect, funcErr
+	}
+
+	if utf8.RuneCountInString(delimiter) != 1 || strings.ContainsAny(delimiter, "\r\n") {
+		return dialect, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf(
+			"Option \"delimiter\" must be a single character other than a line break, got %q.", delimiter))
+	}
+	dialect.delimiter, _ = utf8.DecodeRuneInString(delimiter)
+
+	if quote != "" {
+		if utf8.RuneCountInString(quote) != 1 || strings.ContainsAny(quote, "\r\n") || quote == delimiter {
+			return dialect, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf(
+				"Option \"quote\" must be empty or a single character other than a line break and the delimiter, got %q.", quote))
+		}
+		dialect.quote, _ = utf8.DecodeRuneInString(quote)
+	}
+	return dialect, nil
+}
+
+// csvRecord is a row of a CSV document and the line it starts on.
+type csvRecord struct {
+	fields []string
+	line   int
+}
+
+// parseRecords splits a CSV document into records. Lines may end with LF or
+// CRLF, empty lines are skipped and a leading byte order mark is ignored.
+// Quote characters inside unquoted fields are kept as they are.
+func (d csvDialect) parseRecords(data string) ([]csvRecord, error) {
+	data = strings.TrimPrefix(data, "\uFEFF")
+
+	var records []csvRecord
+	var fields []string
+	var field strings.Builder
+	line, recordLine := 1, 1
+	quoted, atFieldStart, afterQuote := false, true, false
+
+	endField := func() {
+		fields = append(fields, field.String())
+		field.Reset()
+		atFieldStart, afterQuote = true, false
+	}
+	endRecord := func() {
+		if len(fields) > 0 || field.Len() > 0 || afterQuote {
+			endField()
+			records = append(records, csvRecord{fields: fields, line: recordLine})
+		}
+		fields = nil
+		recordLine = line
+	}
+
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRuneInString(data[i:])
+		i += size
+
+		if quoted {
+			switch {
+			case r == d.quote && strings.HasPrefix(data[i:], string(d.quote)):
+				field.WriteRune(r)
+				i += size
+			case r == d.quote:
+				quoted, afterQuote = false, true
+			default:
+				if r == '\n' {
+					line++
+				}
+				field.WriteRune(r)
+			}
+			continue
+		}
+
+		switch {
+		case r == d.delimiter:
+			endField()
+		case r == '\n' || r == '\r':
+			if r == '\r' && strings.HasPrefix(data[i:], "\n") {
+				i++
+			}
+			line++
+			endRecord()
+		case afterQuote:
+			return nil, fmt.Errorf("line %d: unexpected %q after a closing quote, expected the delimiter or a line break", line, r)
+		case r == d.quote && d.quote != 0 && atFieldStart:
+			quoted, atFieldStart = true, false
+		default:
+			field.WriteRune(r)
+			atFieldStart = false
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("line %d: quoted field is not terminated", recordLine)
+	}
+	endRecord()
+	return records, nil
+}
+
+// formatField returns a field, quoted if it contains the delimiter, the
+// quote character, a line break or leading or trailing spaces. It reports
+// false if the field needs quotes but quoting is disabled.
+func (d csvDialect) formatField(s string) (string, bool) {
+	separates := strings.ContainsRune(s, d.delimiter) || strings.ContainsAny(s, "\r\n")
+	if d.quote == 0 {
+		return s, !separates
+	}
+	if !separates && !strings.ContainsRune(s, d.quote) && strings.TrimSpace(s) == s {
+		return s, true
+	}
+	quote := string(d.quote)
+	return quote + strings.ReplaceAll(s, quote, quote+quote) + quote, true
+}
+
+// csvReader converts CSV records into an array of objects.
+type csvReader struct {
+	csvDialect
+	header     any
+	columns    []string
+	inferTypes bool
+}
+
+// parse converts a CSV document into an array of objects, one per row.
+func (c csvReader) parse(data string) ([]any, error) {
+	records, err := c.parseRecords(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) > 0 && c.hasHeader(records[0].fields) {
+		if c.columns == nil {
+			c.columns = records[0].fields
+		}
+		records = records[1:]
+	}
+	if c.columns == nil && len(records) > 0 {
+		for i := range records[0].fields {
+			c.columns = append(c.columns, "column_"+strconv.Itoa(i+1))
+		}
+	}
+	for i, column := range c.columns {
+		if column == "" {
+			return nil, fmt.Errorf("column %d has an empty name", i+1)
+		}
+		if slices.Contains(c.columns[:i], column) {
+			return nil, fmt.Errorf("duplicate column name %q", column)
+		}
+	}
+
+	rows := make([]any, len(records))
+	for i, record := range records {
+		if len(record.fields) != len(c.columns) {
+			return nil, fmt.Errorf("line %d: row has %d fields, expected %d", record.line, len(record.fields), len(c.columns))
+		}
+		row := newJSONObject()
+		for j, field := range record.fields {
+			row.Set(c.columns[j], c.value(field))
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
+
+// hasHeader reports whether fields are a header row. In auto mode, the row
+// is a header when its fields are distinct, non-empty and not numbers or
+// booleans.
+func (c csvReader) hasHeader(fields []string) bool {
+	if header, ok := c.header.(bool); ok {
+		return header
+	}
+	for i, field := range fields {
+		if field == "" || slices.Contains(fields[:i], field) {
+			return false
+		}
+		if _, isString := inferCSVValue(field).(string); !isString {
+			return false
+		}
+	}
+	return true
+}
+
+// value returns the value of a field.
+func (c csvReader) value(field string) any {
+	if !c.inferTypes {
+		return field
+	}
+	return inferCSVValue(field)
+}
+
+// inferCSVValue decodes empty fields as null, true and false in any case as
+// booleans and JSON numbers as numbers. Other fields, including numbers with
+// leading zeros such as postal codes, stay strings.
+func inferCSVValue(field string) any {
+	switch {
+	case field == "":
+		return nil
+	case strings.EqualFold(field, "true"):
+		return true
+	case strings.EqualFold(field, "false"):
+		return false
+	case jsonNumberPattern.MatchString(field):
+		return json.Number(field)
+	default:
+		return field
+	}
+}
+
+// csvWriter converts an array of objects into CSV rows.
+type csvWriter struct {
+	csvDialect
+	header    bool
+	columns   []string
+	flatten   bool
+	separator string
+}
+
+// format converts rows into a CSV document. Nested values are flattened into
+// separate columns, or written as JSON text when flattening is off.
+func (c csvWriter) format(rows []any) (string, error) {
+	cells := make([]map[string]string, len(rows))
+	var discovered []string
+	for i, row := range rows {
+		object, ok := row.(*jsonObject)
+		if !ok {
+			return "", fmt.Errorf("element at index %d must be an object, got %s", i, jsonTypeName(row))
+		}
+		cells[i] = map[string]string{}
+		visit := func(path string, leaf any) error {
+			if _, seen := cells[i][path]; !seen && !slices.Contains(discovered, path) {
+				discovered = append(discovered, path)
+			}
+			cells[i][path] = csvLeafString(leaf)
+			return nil
+		}
+		if c.flatten {
+			if err := walkFlattened(object, c.separator, visit); err != nil {
+				return "", err
+			}
+			continue
+		}
+		for _, key := range object.Keys() {
+			member, _ := object.Get(key)
+			_ = visit(key, member)
+		}
+	}
+
+	columns := c.columns
+	if columns == nil {
+		columns = discovered
+	}
+	if len(columns) == 0 {
+		return "", nil
+	}
+
+	var b strings.Builder
+	writeRow := func(fields []string, location string) error {
+		for i, field := range fields {
+			if i > 0 {
+				b.WriteRune(c.delimiter)
+			}
+			text, ok := c.formatField(field)
+			if !ok {
+				return fmt.Errorf("%s contains the delimiter or a line break and cannot be written without a quote character", location)
+			}
+			b.WriteString(text)
+		}
+		b.WriteByte('\n')
+		return nil
+	}
+
+	if c.header {
+		if err := writeRow(columns, "a column name"); err != nil {
+			return "", err
+		}
+	}
+	for i, row := range cells {
+		fields := make([]string, len(columns))
+		for j, column := range columns {
+			fields[j] = row[column]
+		}
+		if err := writeRow(fields, fmt.Sprintf("a value of the element at index %d", i)); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// csvLeafString returns the text of a cell: strings as they are, null as an
+// empty cell and other values as compact JSON, without the HTML escaping of
+// json.Marshal.
+func csvLeafString(leaf any) string {
+	switch v := leaf.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *jsonObject, []any:
+		var b bytes.Buffer
+		writeCSVJSON(&b, v)
+		return b.String()
+	default:
+		return flattenedLeafString(v)
+	}
+}
+
+// writeCSVJSON writes a value as compact JSON. Members are encoded one by
+// one, as jsonObject.MarshalJSON would apply the HTML escaping of
+// json.Marshal to them whatever the settings of the encoder.
+func writeCSVJSON(b *bytes.Buffer, value any) {
+	switch v := value.(type) {
+	case *jsonObject:
+		b.WriteByte('{')
+		for i, key := range v.Keys() {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			member, _ := v.Get(key)
+			writeCSVJSON(b, key)
+			b.WriteByte(':')
+			writeCSVJSON(b, member)
+		}
+		b.WriteByte('}')
+	case []any:
+		b.WriteByte('[')
+		for i, element := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeCSVJSON(b, element)
+		}
+		b.WriteByte(']')
+	default:
+		encoder := json.NewEncoder(b)
+		encoder.SetEscapeHTML(false)
+		if encoder.Encode(v) == nil {
+			// Drop the line break written after the value.
+			b.Truncate(b.Len() - 1)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = CSVToJSONFunction{}
+)
+
+func NewCSVToJSONFunction() function.Function {
+	return CSVToJSONFunction{}
+}
+
+type CSVToJSONFunction struct{}
+
+func (r CSVToJSONFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "csvtojson")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "csvtojson"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r CSVToJSONFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "csvtojson")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert CSV to a pretty-printed JSON array",
+		MarkdownDescription: `Converts a CSV document to a pretty-printed JSON array with one object per row, keyed by column name in column order.
+
+## Parsing
+
+Fields follow [RFC 4180](https://www.rfc-editor.org/rfc/rfc4180): fields that contain the delimiter, the quote character or line breaks are enclosed in quotes, and a quote inside a quoted field is doubled. Quote characters inside unquoted fields are kept as they are. Lines may end with LF or CRLF, empty lines are skipped, and a byte order mark, as written by spreadsheet applications, is ignored. Every row must have as many fields as there are columns.
+
+## Header
+
+By default, the first row holds the column names. With ` + "`header = false`" + `, every row is data and the columns are named ` + "`column_1`" + `, ` + "`column_2`" + ` and so on, unless ` + "`columns`" + ` names them. With ` + "`header = \"auto\"`" + `, the first row is treated as a header when its fields are distinct, non-empty and none of them is a number or boolean. ` + "`columns`" + ` also replaces the names of a header row.
+
+## Type Inference
+
+By default every value is a string, as with ` + "`csvdecode`" + `. With ` + "`infer_types = true`" + `, empty fields become ` + "`null`" + `, ` + "`true`" + ` and ` + "`false`" + ` in any case become booleans, and JSON numbers become numbers. Numbers with leading zeros, such as postal codes (` + "`\"01234\"`" + `), and other text stay strings.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "csv",
+				MarkdownDescription: "The CSV document to convert.\n\n**Requirements:**\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `header` - `true` (default), `false` or `\"auto\"`: whether the first row holds the column names\n- `columns` - Column names, replacing the header row or naming the columns of a file without one\n- `delimiter` - Field delimiter (default `\",\"`), for example `\";\"` or `\"\\t\"`\n- `quote` - Quote character (default `\"\\\"\"`), or `\"\"` to disable quoting\n- `infer_types` - Decode numbers, booleans and empty fields instead of keeping strings (default `false`)\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ delimiter = \";\", infer_types = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r CSVToJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "csvtojson")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting CSV to JSON function execution")
+
+	var csvString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &csvString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "header", "columns", "delimiter", "quote", "infer_types", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	reader := csvReader{}
+	if reader.csvDialect, funcErr = parseCSVDialect(opts); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if reader.header, funcErr = parseCSVHeaderOption(opts); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if reader.columns, funcErr = opts.stringListOption("columns"); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if reader.inferTypes, funcErr = opts.boolOption("infer_types", false); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if funcErr := validateInputSize(ctx, csvString, "CSV", 0); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	rows, err := reader.parse(csvString)
+	if err != nil {
+		tflog.Error(ctx, "CSV parsing failed", map[string]any{
+			"error_type":    ErrorTypeParsing,
+			"error_code":    "CSV_PARSE_ERROR",
+			"error":         err.Error(),
+			"input_preview": truncateString(csvString, 100),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("CSV parsing error: %v.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, rows, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "CSV to JSON function execution successful", map[string]any{
+		"result_size": len(result),
+		"row_count":   len(rows),
+	})
+}
+
+// parseCSVHeaderOption reads the header option: a boolean or "auto".
+func parseCSVHeaderOption(opts functionOptions) (any, *function.FuncError) {
+	value, ok := opts.values.Get("header")
+	if !ok || value == nil {
+		return true, nil
+	}
+	if _, isBool := value.(bool); isBool || value == CSVHeaderAuto {
+		return value, nil
+	}
+	return nil, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf(
+		"Option \"header\" must be a boolean or %q, got %s.", CSVHeaderAuto, compactJSONText(value)))
+}
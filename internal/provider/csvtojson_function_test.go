@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting CSV to JSON.
+func TestCSVToJSONFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					inventory = "\uFEFFhostname,ip,cores,enabled,zip,notes\r\nweb-1,10.0.0.1,4,TRUE,01234,\"rack 3, \"\"top\"\"\"\r\n\r\nweb-2,10.0.0.2,8.5,false,,\"line1\nline2\"\r\n"
+				}
+				output "test_strings" {
+					value = provider::prettyjson::csvtojson(local.inventory)
+				}
+				output "test_infer_types" {
+					value = jsonencode(jsondecode(provider::prettyjson::csvtojson(local.inventory, { infer_types = true })))
+				}
+				output "test_no_header" {
+					value = jsonencode(jsondecode(provider::prettyjson::csvtojson("a;1\nb;2", { header = false, delimiter = ";" })))
+				}
+				output "test_columns" {
+					value = jsonencode(jsondecode(provider::prettyjson::csvtojson("a\t'x\ty'\n", { header = false, columns = ["name", "value"], delimiter = "\t", quote = "'" })))
+				}
+				output "test_auto_header" {
+					value = jsonencode([
+						jsondecode(provider::prettyjson::csvtojson("name,port\nweb,80", { header = "auto" })),
+						jsondecode(provider::prettyjson::csvtojson("web,80\ndb,5432", { header = "auto" })),
+					])
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_strings", "[\n  {\n    \"hostname\": \"web-1\",\n    \"ip\": \"10.0.0.1\",\n    \"cores\": \"4\",\n    \"enabled\": \"TRUE\",\n    \"zip\": \"01234\",\n    \"notes\": \"rack 3, \\\"top\\\"\"\n  },\n  {\n    \"hostname\": \"web-2\",\n    \"ip\": \"10.0.0.2\",\n    \"cores\": \"8.5\",\n    \"enabled\": \"false\",\n    \"zip\": \"\",\n    \"notes\": \"line1\\nline2\"\n  }\n]"),
+					resource.TestCheckOutput("test_infer_types", `[{"cores":4,"enabled":true,"hostname":"web-1","ip":"10.0.0.1","notes":"rack 3, \"top\"","zip":"01234"},{"cores":8.5,"enabled":false,"hostname":"web-2","ip":"10.0.0.2","notes":"line1\nline2","zip":null}]`),
+					resource.TestCheckOutput("test_no_header", `[{"column_1":"a","column_2":"1"},{"column_1":"b","column_2":"2"}]`),
+					resource.TestCheckOutput("test_columns", `[{"name":"a","value":"x\ty"}]`),
+					resource.TestCheckOutput("test_auto_header", `[[{"name":"web","port":"80"}],[{"column_1":"web","column_2":"80"},{"column_1":"db","column_2":"5432"}]]`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestCSVToJSONFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_ragged_row" {
+					value = provider::prettyjson::csvtojson("a,b\n1,2\n3\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`CSV\s+parsing\s+error:\s+line\s+3:\s+row\s+has\s+1\s+fields,\s+expected\s+2`),
+			},
+			{
+				Config: `
+				output "test_unterminated_quote" {
+					value = provider::prettyjson::csvtojson("a,b\n1,\"2\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`line\s+2:\s+quoted\s+field\s+is\s+not\s+terminated`),
+			},
+			{
+				Config: `
+				output "test_text_after_quote" {
+					value = provider::prettyjson::csvtojson("a\n\"x\"y\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`line\s+2:\s+unexpected\s+'y'\s+after\s+a\s+closing\s+quote`),
+			},
+			{
+				Config: `
+				output "test_duplicate_column" {
+					value = provider::prettyjson::csvtojson("a,a\n1,2\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`duplicate\s+column\s+name\s+"a"`),
+			},
+			{
+				Config: `
+				output "test_invalid_delimiter" {
+					value = provider::prettyjson::csvtojson("a", { delimiter = "::" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Option\s+"delimiter"\s+must\s+be\s+a\s+single\s+character`),
+			},
+			{
+				Config: `
+				output "test_invalid_header" {
+					value = provider::prettyjson::csvtojson("a", { header = "yes" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Option\s+"header"\s+must\s+be\s+a\s+boolean\s+or\s+"auto",\s+got\s+"yes"`),
+			},
+		},
+	})
+}
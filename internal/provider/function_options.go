@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// functionOptions is a decoded options object passed as the optional
+// trailing argument of functions that take more than an indentation type.
+type functionOptions struct {
+	values           *jsonObject
+	argumentPosition int64
+}
+
+// parseOptionsArgument decodes the variadic options argument. At most one
+// options object may be given, and only the listed option names are accepted
+// so that typos are reported instead of silently ignored.
+func parseOptionsArgument(ctx context.Context, options []types.Dynamic, argumentPosition int64, allowed ...string) (functionOptions, *function.FuncError) {
+	result := functionOptions{values: newJSONObject(), argumentPosition: argumentPosition}
+
+	if len(options) == 0 {
+		return result, nil
+	}
+	if len(options) > 1 {
+		return result, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"At most one options object may be provided, got %d.", len(options)))
+	}
+
+	decoded, err := terraformValueToJSON(ctx, options[0])
+	if err != nil {
+		return result, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid options: %v.", err))
+	}
+	if decoded == nil {
+		return result, nil
+	}
+
+	object, ok := decoded.(*jsonObject)
+	if !ok {
+		return result, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"Options must be an object, got %s.", jsonTypeName(decoded)))
+	}
+
+	if funcErr := validateOptionNames(ctx, object, argumentPosition, allowed); funcErr != nil {
+		return result, funcErr
+	}
+
+	tflog.Debug(ctx, "Options parsed", map[string]any{
+		"option_names": object.Keys(),
+	})
+
+	result.values = object
+	return result, nil
+}
+
+// validateOptionNames reports the first member of an options object that is
+// not one of the allowed option names.
+func validateOptionNames(ctx context.Context, object *jsonObject, argumentPosition int64, allowed []string) *function.FuncError {
+	for _, key := range object.Keys() {
+		if !slices.Contains(allowed, key) {
+			tflog.Error(ctx, "Unknown option provided", map[string]any{
+				"error_type":    ErrorTypeValidation,
+				"error_code":    "UNKNOWN_OPTION",
+				"option":        key,
+				"valid_options": allowed,
+			})
+			return function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+				"Unknown option %q. Valid options are: %s.", key, strings.Join(allowed, ", ")))
+		}
+	}
+	return nil
+}
+
+// stringOption returns a string option. When validValues is non-empty the
+// value must be one of them.
+func (o functionOptions) stringOption(name, defaultValue string, validValues ...string) (string, *function.FuncError) {
+	value, ok := o.values.Get(name)
+	if !ok || value == nil {
+		return defaultValue, nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", function.NewArgumentFuncError(o.argumentPosition, fmt.Sprintf(
+			"Option %q must be a string, got %s.", name, jsonTypeName(value)))
+	}
+	if len(validValues) > 0 && !slices.Contains(validValues, s) {
+		return "", function.NewArgumentFuncError(o.argumentPosition, fmt.Sprintf(
+			"Invalid value %q for option %q. Valid values are: %s.", s, name, strings.Join(validValues, ", ")))
+	}
+	return s, nil
+}
+
+// boolOption returns a boolean option.
+func (o functionOptions) boolOption(name string, defaultValue bool) (bool, *function.FuncError) {
+	value, ok := o.values.Get(name)
+	if !ok || value == nil {
+		return defaultValue, nil
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, function.NewArgumentFuncError(o.argumentPosition, fmt.Sprintf(
+			"Option %q must be a boolean, got %s.", name, jsonTypeName(value)))
+	}
+	return b, nil
+}
+
+// intOption returns a whole number option within [minValue, maxValue].
+func (o functionOptions) intOption(name string, defaultValue, minValue, maxValue int) (int, *function.FuncError) {
+	value, ok := o.values.Get(name)
+	if !ok || value == nil {
+		return defaultValue, nil
+	}
+	number, isNumber := value.(json.Number)
+	n, err := strconv.Atoi(number.String())
+	if !isNumber || err != nil || n < minValue || n > maxValue {
+		return 0, function.NewArgumentFuncError(o.argumentPosition, fmt.Sprintf(
+			"Option %q must be a whole number between %d and %d, got %s.", name, minValue, maxValue, compactJSONText(value)))
+	}
+	return n, nil
+}
+
+// stringListOption returns an option that holds a list of strings.
+func (o functionOptions) stringListOption(name string) ([]string, *function.FuncError) {
+	value, ok := o.values.Get(name)
+	if !ok || value == nil {
+		return nil, nil
+	}
+	list, ok := stringList(value)
+	if !ok {
+		return nil, function.NewArgumentFuncError(o.argumentPosition, fmt.Sprintf(
+			"Option %q must be a list of strings, got %s.", name, jsonTypeName(value)))
+	}
+	return list, nil
+}
+
+// stringList converts a decoded JSON array of strings.
+func stringList(value any) ([]string, bool) {
+	array, ok := value.([]any)
+	if !ok {
+		return nil, false
+	}
+	list := make([]string, len(array))
+	for i, element := range array {
+		s, ok := element.(string)
+		if !ok {
+			return nil, false
+		}
+		list[i] = s
+	}
+	return list, true
+}
+
+// indentation resolves the indentation_type option shared by all functions
+// that format their result.
+func (o functionOptions) indentation(ctx context.Context) (string, *function.FuncError) {
+	indentationType, funcErr := o.stringOption("indentation_type", DefaultIndentationType)
+	if funcErr != nil {
+		return "", funcErr
+	}
+	return resolveIndentation(ctx, indentationType, o.argumentPosition)
+}
+
+// parseIndentationOptionsArgument reads the options argument of functions
+// whose only option is the indentation type. Like jsonprettyprint, it accepts
+// either an options object or a bare indentation type.
+func parseIndentationOptionsArgument(ctx context.Context, options []types.Dynamic, argumentPosition int64) (string, *function.FuncError) {
+	if len(options) > 0 {
+		decoded, err := terraformValueToJSON(ctx, options[0])
+		if err != nil {
+			return "", function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid options: %v.", err))
+		}
+		if indentationType, ok := decoded.(string); ok {
+			return resolveIndentation(ctx, indentationTypeArgument(ctx, []string{indentationType}), argumentPosition)
+		}
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, argumentPosition, "indentation_type")
+	if funcErr != nil {
+		return "", funcErr
+	}
+	return opts.indentation(ctx)
+}
@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// hclHeredocMarker is the delimiter of heredoc strings, suffixed with a
+// number when a line of the string matches it.
+const hclHeredocMarker = "EOT"
+
+// parseHCLDocument parses a body of HCL attributes into an object, keeping
+// attributes and object members in source order. Only literal values are
+// accepted, see hclCheckLiteral.
+func parseHCLDocument(data string) (*jsonObject, error) {
+	file, diags := hclsyntax.ParseConfig([]byte(data), "input.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, hclDiagnosticsError(diags)
+	}
+	body := file.Body.(*hclsyntax.Body)
+
+	if len(body.Blocks) > 0 {
+		block := body.Blocks[0]
+		return nil, fmt.Errorf("line %d: block %q is not supported, only attributes can be converted", block.TypeRange.Start.Line, block.Type)
+	}
+
+	attributes := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attribute := range body.Attributes {
+		attributes = append(attributes, attribute)
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].SrcRange.Start.Byte < attributes[j].SrcRange.Start.Byte
+	})
+
+	document := newJSONObject()
+	for _, attribute := range attributes {
+		value, err := hclExpressionValue(attribute.Expr)
+		if err != nil {
+			return nil, err
+		}
+		document.Set(attribute.Name, value)
+	}
+	return document, nil
+}
+
+// hclExpressionValue converts an expression into a document value. Object
+// and tuple constructors are walked to keep member order; other expressions
+// must be literal and are evaluated.
+func hclExpressionValue(expr hclsyntax.Expression) (any, error) {
+	switch e := expr.(type) {
+	case *hclsyntax.ObjectConsExpr:
+		object := newJSONObject()
+		for _, item := range e.Items {
+			if err := hclCheckLiteral(item.KeyExpr); err != nil {
+				return nil, err
+			}
+			key, diags := item.KeyExpr.Value(nil)
+			if diags.HasErrors() {
+				return nil, hclDiagnosticsError(diags)
+			}
+			key, err := convert.Convert(key, cty.String)
+			if err != nil || key.IsNull() {
+				start := item.KeyExpr.Range().Start
+				return nil, fmt.Errorf("line %d, column %d: object key must be a string", start.Line, start.Column)
+			}
+			value, err := hclExpressionValue(item.ValueExpr)
+			if err != nil {
+				return nil, err
+			}
+			object.Set(key.AsString(), value)
+		}
+		return object, nil
+	case *hclsyntax.TupleConsExpr:
+		array := make([]any, len(e.Exprs))
+		for i, element := range e.Exprs {
+			value, err := hclExpressionValue(element)
+			if err != nil {
+				return nil, err
+			}
+			array[i] = value
+		}
+		return array, nil
+	case *hclsyntax.ParenthesesExpr:
+		return hclExpressionValue(e.Expression)
+	}
+
+	if err := hclCheckLiteral(expr); err != nil {
+		return nil, err
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() {
+		return nil, hclDiagnosticsError(diags)
+	}
+	return ctyDocumentValue(value), nil
+}
+
+// hclCheckLiteral checks that an expression other than a tuple or object
+// constructor is a literal: a value, a string or heredoc without
+// interpolations or directives, a negated number or an object key given as
+// an identifier.
+func hclCheckLiteral(expr hclsyntax.Expression) error {
+	switch e := expr.(type) {
+	case *hclsyntax.LiteralValueExpr:
+		return nil
+	case *hclsyntax.TemplateExpr:
+		for _, part := range e.Parts {
+			if _, ok := part.(*hclsyntax.LiteralValueExpr); !ok {
+				return hclNotLiteralError(part)
+			}
+		}
+		return nil
+	case *hclsyntax.UnaryOpExpr:
+		if operand, ok := e.Val.(*hclsyntax.LiteralValueExpr); ok && e.Op == hclsyntax.OpNegate && operand.Val.Type() == cty.Number {
+			return nil
+		}
+	case *hclsyntax.ParenthesesExpr:
+		return hclCheckLiteral(e.Expression)
+	case *hclsyntax.ObjectConsKeyExpr:
+		if !e.ForceNonLiteral && hcl.ExprAsKeyword(e.Wrapped) != "" {
+			return nil
+		}
+		return hclCheckLiteral(e.Wrapped)
+	}
+	return hclNotLiteralError(expr)
+}
+
+// hclNotLiteralError reports an expression that is not a literal.
+func hclNotLiteralError(expr hclsyntax.Expression) error {
+	start := expr.Range().Start
+	return fmt.Errorf("line %d, column %d: only literal values can be converted, not references, function calls, operators or interpolations", start.Line, start.Column)
+}
+
+// ctyDocumentValue converts an evaluated value into a document value. Map
+// and object members are in lexical order.
+func ctyDocumentValue(value cty.Value) any {
+	if value.IsNull() {
+		return nil
+	}
+	valueType := value.Type()
+	switch {
+	case valueType == cty.String:
+		return value.AsString()
+	case valueType == cty.Number:
+		return json.Number(hclNumberText(value.AsBigFloat()))
+	case valueType == cty.Bool:
+		return value.True()
+	case valueType.IsObjectType() || valueType.IsMapType():
+		object := newJSONObject()
+		for it := value.ElementIterator(); it.Next(); {
+			key, element := it.Element()
+			object.Set(key.AsString(), ctyDocumentValue(element))
+		}
+		return object
+	default:
+		array := []any{}
+		for it := value.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			array = append(array, ctyDocumentValue(element))
+		}
+		return array
+	}
+}
+
+// hclNumberText returns the shortest decimal text that reads back as f. As in
+// encoding/json, numbers from 1e-6 up to 1e21 are written without an
+// exponent and others, such as 1e-300 or 1e+400, with one.
+func hclNumberText(f *big.Float) string {
+	mantissa, exponent, _ := strings.Cut(f.Text('e', -1), "e")
+	exp, _ := strconv.Atoi(exponent)
+	if f.Sign() == 0 || exp >= -6 && exp < 21 {
+		return f.Text('f', -1)
+	}
+	return fmt.Sprintf("%se%+d", mantissa, exp)
+}
+
+// hclDiagnosticsError returns the first error of diags with its position.
+func hclDiagnosticsError(diags hcl.Diagnostics) error {
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+		message := diag.Detail
+		if message == "" {
+			message = diag.Summary
+		}
+		message = strings.TrimSuffix(message, ".")
+		if diag.Subject == nil {
+			return fmt.Errorf("%s", message)
+		}
+		return fmt.Errorf("line %d, column %d: %s", diag.Subject.Start.Line, diag.Subject.Start.Column, message)
+	}
+	return diags
+}
+
+// hclWriter renders a document as HCL attributes.
+type hclWriter struct {
+	b        strings.Builder
+	heredocs bool
+}
+
+// formatHCLDocument renders an object as HCL attributes, formatted as
+// terraform fmt would.
+func formatHCLDocument(document any, heredocs bool) (string, error) {
+	object, ok := document.(*jsonObject)
+	if !ok {
+		return "", fmt.Errorf("document must be a JSON object, got %s", jsonTypeName(document))
+	}
+
+	w := &hclWriter{heredocs: heredocs}
+	for _, key := range object.Keys() {
+		if !hclsyntax.ValidIdentifier(key) {
+			return "", fmt.Errorf("key %q at %q is not a valid HCL attribute name", key, jsonPointer{key}.String())
+		}
+		member, _ := object.Get(key)
+		w.b.WriteString(key)
+		w.b.WriteString(" = ")
+		w.writeValue(member, 0)
+		w.b.WriteByte('\n')
+	}
+	return string(hclwrite.Format([]byte(w.b.String()))), nil
+}
+
+// writeValue writes a value at the given depth. Objects and arrays holding
+// objects or arrays span several lines; indentation is left to
+// hclwrite.Format, except within heredocs.
+func (w *hclWriter) writeValue(value any, depth int) {
+	switch v := value.(type) {
+	case nil:
+		w.b.WriteString("null")
+	case bool:
+		fmt.Fprintf(&w.b, "%t", v)
+	case json.Number:
+		w.b.WriteString(v.String())
+	case string:
+		if w.heredocs && hclHeredocCandidate(v) {
+			w.writeHeredoc(v, depth)
+		} else {
+			w.b.WriteString(hclQuote(v))
+		}
+	case []any:
+		if len(v) == 0 {
+			w.b.WriteString("[]")
+			return
+		}
+		inline := true
+		for _, element := range v {
+			switch element.(type) {
+			case *jsonObject, []any:
+				inline = false
+			}
+		}
+		if inline {
+			w.b.WriteByte('[')
+			for i, element := range v {
+				if i > 0 {
+					w.b.WriteString(", ")
+				}
+				w.writeElement(element, depth)
+			}
+			w.b.WriteByte(']')
+			return
+		}
+		w.b.WriteString("[\n")
+		for _, element := range v {
+			w.writeElement(element, depth+1)
+			w.b.WriteString(",\n")
+		}
+		w.b.WriteByte(']')
+	case *jsonObject:
+		if v.Len() == 0 {
+			w.b.WriteString("{}")
+			return
+		}
+		w.b.WriteString("{\n")
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			w.b.WriteString(hclObjectKey(key))
+			w.b.WriteString(" = ")
+			w.writeValue(member, depth+1)
+			w.b.WriteByte('\n')
+		}
+		w.b.WriteByte('}')
+	}
+}
+
+// writeElement writes an array element. Strings are always quoted, as the
+// comma after an element cannot follow the closing marker of a heredoc.
+func (w *hclWriter) writeElement(element any, depth int) {
+	if s, ok := element.(string); ok {
+		w.b.WriteString(hclQuote(s))
+		return
+	}
+	w.writeValue(element, depth)
+}
+
+// writeHeredoc writes a multi-line string as a heredoc. Lines are indented
+// to the depth of the value in a flush heredoc, unless all of them are
+// already indented, which a flush heredoc would strip.
+func (w *hclWriter) writeHeredoc(s string, depth int) {
+	lines := strings.SplitAfter(strings.TrimSuffix(s, "\n"), "\n")
+
+	marker := hclHeredocMarker
+	for n := 1; ; n++ {
+		clash := false
+		for _, line := range lines {
+			clash = clash || strings.TrimSpace(line) == marker
+		}
+		if !clash {
+			break
+		}
+		marker = fmt.Sprintf("%s%d", hclHeredocMarker, n)
+	}
+
+	flush := false
+	for _, line := range lines {
+		if first, _ := utf8.DecodeRuneInString(line); strings.TrimSpace(line) != "" && !unicode.IsSpace(first) {
+			flush = true
+		}
+	}
+	indent := ""
+	if flush {
+		indent = strings.Repeat("  ", depth+1)
+		w.b.WriteString("<<-")
+	} else {
+		w.b.WriteString("<<")
+	}
+
+	w.b.WriteString(marker)
+	w.b.WriteByte('\n')
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			w.b.WriteString(indent)
+		}
+		w.b.WriteString(hclEscapeTemplate(strings.TrimSuffix(line, "\n")))
+		w.b.WriteByte('\n')
+	}
+	w.b.WriteString(strings.Repeat("  ", depth))
+	w.b.WriteString(marker)
+}
+
+// hclHeredocCandidate reports whether a string reads better as a heredoc:
+// it has several lines, ends with a line break and has no other control
+// characters than tabs.
+func hclHeredocCandidate(s string) bool {
+	if !strings.HasSuffix(s, "\n") || strings.Count(s, "\n") < 2 || strings.TrimSpace(s) == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < 0x20 && r != '\n' && r != '\t' || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// hclObjectKey returns an object key, quoted unless it is an identifier
+// other than the keywords null, true and false.
+func hclObjectKey(key string) string {
+	switch key {
+	case "null", "true", "false":
+	default:
+		if hclsyntax.ValidIdentifier(key) {
+			return key
+		}
+	}
+	return hclQuote(key)
+}
+
+// hclQuote returns s as a quoted HCL string. Template sequences are escaped
+// so that the string is taken literally.
+func hclQuote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range hclEscapeTemplate(s) {
+		switch r {
+		case '\\', '"':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// hclEscapeTemplate escapes the interpolation and directive sequences ${
+// and %{ as $${ and %%{.
+func hclEscapeTemplate(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(s)
+}
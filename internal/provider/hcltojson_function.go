@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = HCLToJSONFunction{}
+)
+
+func NewHCLToJSONFunction() function.Function {
+	return HCLToJSONFunction{}
+}
+
+type HCLToJSONFunction struct{}
+
+func (r HCLToJSONFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "hcltojson")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "hcltojson"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r HCLToJSONFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "hcltojson")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert HCL attributes to pretty-printed JSON",
+		MarkdownDescription: `Converts a body of HCL attributes, such as a ` + "`.tfvars`" + ` file, to pretty-printed JSON, preserving the order of attributes and object members.
+
+## Conversion
+
+- Strings, numbers, booleans and ` + "`null`" + ` become the same JSON values, tuples become arrays and objects become objects.
+- Only literal values are accepted: numbers, including negative ones, strings and heredocs without interpolations, booleans, ` + "`null`" + `, tuples and objects. References such as ` + "`var.region`" + `, function calls, operators such as ` + "`2 * 3`" + `, conditionals, ` + "`for`" + ` expressions and interpolations such as ` + "`\"${x}\"`" + ` are rejected with their line and column.
+- Blocks, such as ` + "`resource`" + ` or ` + "`locals`" + `, are rejected, as they have no single JSON representation.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hcl",
+				MarkdownDescription: "The HCL attributes to convert.\n\n**Requirements:**\n- Must be valid HCL native syntax\n- Must contain attributes only\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ indentation_type = \"4spaces\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r HCLToJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "hcltojson")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting HCL to JSON function execution")
+
+	var hclString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &hclString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if funcErr := validateInputSize(ctx, hclString, "HCL", 0); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, err := parseHCLDocument(hclString)
+	if err != nil {
+		tflog.Error(ctx, "HCL parsing failed", map[string]any{
+			"error_type":    ErrorTypeParsing,
+			"error_code":    "HCL_PARSE_ERROR",
+			"error":         err.Error(),
+			"input_preview": truncateString(hclString, 100),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("HCL parsing error: %s.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "HCL to JSON function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting HCL to JSON.
+func TestHCLToJSONFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					tfvars = <<-EOT
+						# Comments are ignored
+						zone  = "b"
+						count = -6
+						ratio = 0.25
+						tags = {
+						  team = "web"
+						  "cost-center" = 42
+						}
+						subnets = ["10.0.1.0/24", null]
+						script = <<-SCRIPT
+						  #!/bin/sh
+						  echo hello
+						SCRIPT
+					EOT
+				}
+				output "test_default" {
+					value = provider::prettyjson::hcltojson(local.tfvars)
+				}
+				output "test_indentation" {
+					value = provider::prettyjson::hcltojson("a = { b = [true] }", { indentation_type = "tab" })
+				}
+				output "test_numbers" {
+					value = provider::prettyjson::hcltojson("tiny = 1e-300\nhuge = 1e400\nsmall = 0.000001\nlarge = 12345678901234567890")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", `{
+  "zone": "b",
+  "count": -6,
+  "ratio": 0.25,
+  "tags": {
+    "team": "web",
+    "cost-center": 42
+  },
+  "subnets": [
+    "10.0.1.0/24",
+    null
+  ],
+  "script": "#!/bin/sh\necho hello\n"
+}`),
+					resource.TestCheckOutput("test_indentation", "{\n\t\"a\": {\n\t\t\"b\": [\n\t\t\ttrue\n\t\t]\n\t}\n}"),
+					resource.TestCheckOutput("test_numbers", "{\n  \"tiny\": 1e-300,\n  \"huge\": 1e+400,\n  \"small\": 0.000001,\n  \"large\": 12345678901234567890\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestHCLToJSONFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_reference" {
+					value = provider::prettyjson::hcltojson("a = 1\nb = var.region")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`HCL\s+parsing\s+error:\s+line\s+2,\s+column\s+5:\s+only\s+literal\s+values\s+can\s+be\s+converted`),
+			},
+			{
+				Config: `
+				output "test_operator" {
+					value = provider::prettyjson::hcltojson("a = { b = [2 * 3] }")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`HCL\s+parsing\s+error:\s+line\s+1,\s+column\s+12:\s+only\s+literal\s+values\s+can\s+be\s+converted`),
+			},
+			{
+				Config: `
+				output "test_interpolation" {
+					value = provider::prettyjson::hcltojson("a = \"x-$${upper(\"y\")}\"")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`HCL\s+parsing\s+error:\s+line\s+1,\s+column\s+10:\s+only\s+literal\s+values\s+can\s+be\s+converted`),
+			},
+			{
+				Config: `
+				output "test_for" {
+					value = provider::prettyjson::hcltojson("a = [for x in [1] : x]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`HCL\s+parsing\s+error:\s+line\s+1,\s+column\s+5:\s+only\s+literal\s+values\s+can\s+be\s+converted`),
+			},
+			{
+				Config: `
+				output "test_block" {
+					value = provider::prettyjson::hcltojson("locals {\n}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`line\s+1:\s+block\s+"locals"\s+is\s+not\s+supported`),
+			},
+			{
+				Config: `
+				output "test_syntax" {
+					value = provider::prettyjson::hcltojson("a = ")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`HCL\s+parsing\s+error:\s+line\s+1`),
+			},
+			{
+				Config: `
+				output "test_empty" {
+					value = provider::prettyjson::hcltojson("")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`HCL\s+input\s+cannot\s+be\s+empty`),
+			},
+		},
+	})
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmespath/go-jmespath"
+)
+
+var (
+	_ function.Function = JMESPathFunction{}
+)
+
+func NewJMESPathFunction() function.Function {
+	return JMESPathFunction{}
+}
+
+type JMESPathFunction struct{}
+
+func (r JMESPathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jmespath")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jmespath"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JMESPathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jmespath")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Query a JSON document with a JMESPath expression",
+		MarkdownDescription: `Evaluates a [JMESPath](https://jmespath.org/specification.html) expression against a JSON document and returns the pretty-printed result.
+
+## Overview
+
+The full JMESPath specification is supported, including projections, filters, multi-select lists and hashes, pipes and the built-in functions, and results match the official compliance suite. The result can be any JSON value and is formatted with the same indentation options as ` + "`jsonprettyprint`" + `. An expression that selects nothing returns ` + "`null`" + `.
+
+Like ` + "`jsonprettyprint`" + `, objects in the result have their keys sorted. Numbers are evaluated as IEEE 754 double precision values, as JMESPath comparisons and functions require, so integers beyond 2^53 such as ` + "`9007199254740993`" + ` lose precision, even when they are selected without being compared.
+
+## Examples
+
+- ` + "`spec.containers[*].image`" + `
+- ` + "`spec.containers[?enabled].{name: name, image: image}`" + `
+- ` + "`length(items[?status == 'Running'])`" + `
+- ` + "`sort_by(users, &age)[-1].name`" + `
+
+Invalid expressions are rejected with the position of the offending character; runtime errors such as passing a string to ` + "`sum()`" + ` are reported as function errors.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to query.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "The JMESPath expression.\n\n**Example:** `\"spec.containers[?enabled].image\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional indentation type or options object.\n\n**Valid Indentation Types:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n\n**Options Object:**\n- `indentation_type` - One of the indentation types above\n\n**Example:**\n`{ indentation_type = \"tab\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JMESPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jmespath")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JMESPath function execution")
+
+	var documentString string
+	var expression string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &expression, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	indent, funcErr := parseIndentationOptionsArgument(ctx, options, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	query, err := jmespath.Compile(expression)
+	if err != nil {
+		message := ": " + err.Error()
+		var syntaxErr jmespath.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			message = fmt.Sprintf(" at position %d: %s", syntaxErr.Offset, strings.TrimPrefix(syntaxErr.Error(), "SyntaxError: "))
+		}
+		tflog.Error(ctx, "Invalid JMESPath expression", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "INVALID_JMESPATH",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid JMESPath expression%s.", message))
+		return
+	}
+
+	if funcErr := validateJSONInput(ctx, documentString, 0); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	// go-jmespath compares and computes only float64 numbers, so the
+	// document is decoded without UseNumber, at the cost of the precision
+	// of integers beyond 2^53.
+	var document any
+	if err := json.Unmarshal([]byte(documentString), &document); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("JSON parsing error: %v.", err))
+		return
+	}
+
+	value, err := query.Search(document)
+	if err != nil {
+		tflog.Error(ctx, "JMESPath evaluation failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "JMESPATH_EVALUATION_FAILED",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewFuncError(fmt.Sprintf("JMESPath evaluation failed: %v.", err))
+		return
+	}
+
+	result, funcErr := formatGenericJSONResult(ctx, value, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JMESPath function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
+
+// formatGenericJSONResult formats a value produced by a library that works
+// on the generic encoding/json representation. Round-tripping through the
+// document model gives the same output as the other functions.
+func formatGenericJSONResult(ctx context.Context, value any, indent string) (string, *function.FuncError) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", function.NewFuncError(fmt.Sprintf("JSON formatting failed: %v.", err))
+	}
+	document, err := parseJSONDocument(string(encoded))
+	if err != nil {
+		return "", function.NewFuncError(fmt.Sprintf("JSON formatting failed: %v.", err))
+	}
+	return formatJSONResult(ctx, document, indent)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for projections, filters and functions.
+func TestJMESPathFunction_Basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = jsonencode({
+						people = [
+							{ name = "alice", age = 34, state = "WA" },
+							{ name = "bob", age = 27, state = "OR" },
+							{ name = "carol", age = 41, state = "WA" },
+						]
+					})
+				}
+				output "test_projection" {
+					value = provider::prettyjson::jmespath(local.doc, "people[*].name")
+				}
+				output "test_filter" {
+					value = provider::prettyjson::jmespath(local.doc, "people[?state == 'WA'].name | sort(@)")
+				}
+				output "test_multiselect_hash" {
+					value = provider::prettyjson::jmespath(local.doc, "people[0].{n: name, a: age}")
+				}
+				output "test_function" {
+					value = provider::prettyjson::jmespath(local.doc, "max_by(people, &age).name")
+				}
+				output "test_scalar" {
+					value = provider::prettyjson::jmespath(local.doc, "length(people)")
+				}
+				output "test_no_match" {
+					value = provider::prettyjson::jmespath(local.doc, "owners")
+				}
+				output "test_literal" {
+					value = provider::prettyjson::jmespath("{}", "` + "`" + `{\"b\": [1, 2.5]}` + "`" + `", "tab")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_projection", "[\n  \"alice\",\n  \"bob\",\n  \"carol\"\n]"),
+					resource.TestCheckOutput("test_filter", "[\n  \"alice\",\n  \"carol\"\n]"),
+					resource.TestCheckOutput("test_multiselect_hash", "{\n  \"a\": 34,\n  \"n\": \"alice\"\n}"),
+					resource.TestCheckOutput("test_function", "\"carol\""),
+					resource.TestCheckOutput("test_scalar", "3"),
+					resource.TestCheckOutput("test_no_match", "null"),
+					resource.TestCheckOutput("test_literal", "{\n\t\"b\": [\n\t\t1,\n\t\t2.5\n\t]\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJMESPathFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_syntax_error" {
+					value = provider::prettyjson::jmespath("{}", "people[?")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"expression"\s+parameter:\s+Invalid\s+JMESPath\s+expression\s+at\s+position\s+\d+`),
+			},
+			{
+				Config: `
+				output "test_invalid_type" {
+					value = provider::prettyjson::jmespath("{\"a\":\"x\"}", "sum(a)")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`JMESPath\s+evaluation\s+failed`),
+			},
+			{
+				Config: `
+				output "test_invalid_document" {
+					value = provider::prettyjson::jmespath("{invalid}", "a")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+syntax\s+detected`),
+			},
+			{
+				Config: `
+				output "test_invalid_indent" {
+					value = provider::prettyjson::jmespath("{}", "a", "3spaces")
+				}
+				`,
+				ExpectError: regexp.MustCompile("(?i)invalid.*indentation"),
+			},
+		},
+	})
+}
+
+// Test running the JMESPath compliance suite in testdata/jmespath through
+// the function. Run is called directly, as starting Terraform for each of
+// the cases would take minutes.
+func TestJMESPathFunction_Compliance(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "jmespath", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no compliance fixtures found in testdata/jmespath")
+	}
+
+	ctx := context.Background()
+	f := NewJMESPathFunction()
+	cases := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var suites []struct {
+			Given json.RawMessage `json:"given"`
+			Cases []struct {
+				Expression string `json:"expression"`
+				Result     any    `json:"result"`
+				Error      string `json:"error"`
+			} `json:"cases"`
+		}
+		if err := json.Unmarshal(data, &suites); err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+
+		for _, suite := range suites {
+			for _, c := range suite.Cases {
+				cases++
+				req := function.RunRequest{
+					Arguments: function.NewArgumentsData([]attr.Value{
+						types.StringValue(string(suite.Given)),
+						types.StringValue(c.Expression),
+						types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+					}),
+				}
+				resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+				f.Run(ctx, req, resp)
+
+				if c.Error != "" {
+					if resp.Error == nil {
+						t.Errorf("%s: %q: expected a %s error", filepath.Base(file), c.Expression, c.Error)
+					}
+					continue
+				}
+				if resp.Error != nil {
+					t.Errorf("%s: %q: unexpected error: %v", filepath.Base(file), c.Expression, resp.Error)
+					continue
+				}
+				var actual any
+				if err := json.Unmarshal([]byte(resp.Result.Value().(types.String).ValueString()), &actual); err != nil {
+					t.Errorf("%s: %q: result is not JSON: %v", filepath.Base(file), c.Expression, err)
+					continue
+				}
+				if !reflect.DeepEqual(actual, c.Result) {
+					t.Errorf("%s: %q: got %#v, want %#v", filepath.Base(file), c.Expression, actual, c.Result)
+				}
+			}
+		}
+	}
+	t.Logf("ran %d compliance cases", cases)
+}
@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/itchyny/gojq"
+)
+
+var (
+	_ function.Function = JQFunction{}
+)
+
+// Limits applied to jq programs.
+const (
+	JQExecutionTimeout = 10 * time.Second
+	JQMaxOutputSize    = MaxJSONSize
+)
+
+// Output modes supported by jq.
+const (
+	JQOutputSingle = "single"
+	JQOutputArray  = "array"
+)
+
+var jqVariableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func NewJQFunction() function.Function {
+	return JQFunction{}
+}
+
+type JQFunction struct{}
+
+func (r JQFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jq")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jq"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JQFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jq")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Transform a JSON document with a jq program",
+		MarkdownDescription: `Runs a [jq](https://jqlang.github.io/jq/manual/) program against a JSON document and returns the pretty-printed result.
+
+## Overview
+
+The full jq language is available, including paths, ` + "`select`" + `, ` + "`map`" + `, ` + "`to_entries`" + `/` + "`from_entries`" + `, ` + "`reduce`" + `, string interpolation and user-defined functions, so existing jq transforms can run in-provider instead of through an ` + "`external`" + ` data source. Large integers keep their precision. As with ` + "`jsonprettyprint`" + `, object keys in the result are sorted.
+
+## Variables
+
+Each member of the ` + "`args`" + ` object is available as a variable, like ` + "`jq --arg`" + ` for strings and ` + "`jq --argjson`" + ` for other values. ` + "`{ env = \"prod\" }`" + ` makes ` + "`$env`" + ` available, and ` + "`$ARGS.named`" + ` holds all of them.
+
+## Outputs
+
+A jq program can produce any number of outputs. By default exactly one output is expected; with ` + "`output = \"array\"`" + ` all outputs are collected into a JSON array.
+
+## Sandbox
+
+The program runs offline: environment variables are not visible (` + "`env`" + ` and ` + "`$ENV`" + ` are empty), ` + "`input`" + `/` + "`inputs`" + ` and modules are not available, execution is limited to 10 seconds and the output to 10MB.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document passed to the program as its input.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "program",
+				MarkdownDescription: "The jq program.\n\n**Example:** `\"[.items[] | select(.env == $env) | .name]\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.DynamicParameter{
+				Name:                "args",
+				MarkdownDescription: "Object of variables for the program, or `null`. Keys must be valid jq identifiers.\n\n**Example:** `{ env = \"prod\", min_replicas = 2 }`",
+				AllowNullValue:      true,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `output` - `\"single\"` (default) or `\"array\"`\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ output = \"array\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JQFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jq")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting jq function execution")
+
+	var documentString string
+	var program string
+	var args types.Dynamic
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &program, &args, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 3, "output", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	output, funcErr := opts.stringOption("output", JQOutputSingle, JQOutputSingle, JQOutputArray)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	names, values, funcErr := jqVariables(ctx, args, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	code, funcErr := compileJQProgram(ctx, program, names, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	outputs, err := runJQProgram(ctx, code, jsonValueToGeneric(document), values)
+	if err != nil {
+		tflog.Error(ctx, "jq program failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "JQ_EXECUTION_FAILED",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	var resultValue any = outputs
+	if output == JQOutputSingle {
+		if len(outputs) != 1 {
+			resp.Error = function.NewFuncError(fmt.Sprintf(
+				"jq program produced %d outputs, expected exactly one. Wrap the program in [ ] to collect "+
+					"its outputs, or set output = \"array\".", len(outputs)))
+			return
+		}
+		resultValue = outputs[0]
+	}
+
+	result, funcErr := formatJSONResult(ctx, resultValue, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "jq function execution successful", map[string]any{
+		"result_size":  len(result),
+		"output_count": len(outputs),
+	})
+}
+
+// jqVariables converts the args object into variable names and values. The
+// $ARGS variable is always defined, mirroring the jq command line.
+func jqVariables(ctx context.Context, args types.Dynamic, argumentPosition int64) ([]string, []any, *function.FuncError) {
+	named := map[string]any{}
+	var names []string
+
+	if !args.IsNull() && !args.IsUnderlyingValueNull() {
+		decoded, err := terraformValueToJSON(ctx, args)
+		if err != nil {
+			return nil, nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid args: %v.", err))
+		}
+		object, ok := decoded.(*jsonObject)
+		if !ok {
+			return nil, nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+				"Args must be an object, got %s.", jsonTypeName(decoded)))
+		}
+		for _, key := range object.Keys() {
+			if !jqVariableNamePattern.MatchString(key) || key == "ARGS" {
+				return nil, nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+					"Invalid variable name %q, names must be jq identifiers such as \"env\" or \"min_replicas\".", key))
+			}
+			value, _ := object.Get(key)
+			named[key] = jsonValueToGeneric(value)
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+
+	variables := []string{"$ARGS"}
+	values := []any{map[string]any{"named": named, "positional": []any{}}}
+	for _, name := range names {
+		variables = append(variables, "$"+name)
+		values = append(values, named[name])
+	}
+	return variables, values, nil
+}
+
+// compileJQProgram parses and compiles a jq program without a module loader
+// or environment, so the program cannot reach outside the sandbox.
+func compileJQProgram(ctx context.Context, program string, variables []string, argumentPosition int64) (*gojq.Code, *function.FuncError) {
+	query, err := gojq.Parse(program)
+	if err != nil {
+		message := err.Error()
+		var parseErr *gojq.ParseError
+		if errors.As(err, &parseErr) {
+			message = fmt.Sprintf("at position %d: %s", parseErr.Offset, parseErr.Error())
+		}
+		tflog.Error(ctx, "Invalid jq program", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "JQ_PARSE_ERROR",
+			"error":      err.Error(),
+		})
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid jq program %s.", message))
+	}
+
+	code, err := gojq.Compile(query, gojq.WithVariables(variables))
+	if err != nil {
+		tflog.Error(ctx, "jq compilation failed", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "JQ_COMPILE_ERROR",
+			"error":      err.Error(),
+		})
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid jq program: %v.", err))
+	}
+	return code, nil
+}
+
+// runJQProgram runs a compiled program within the execution time and output
+// size limits and returns its outputs converted to the document model.
+func runJQProgram(ctx context.Context, code *gojq.Code, input any, variables []any) ([]any, error) {
+	runCtx, cancel := context.WithTimeout(ctx, JQExecutionTimeout)
+	defer cancel()
+
+	outputs := []any{}
+	outputSize := 0
+	iter := code.RunWithContext(runCtx, input, variables...)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			return outputs, nil
+		}
+		if err, isErr := value.(error); isErr {
+			var haltErr *gojq.HaltError
+			if errors.As(err, &haltErr) && haltErr.Value() == nil {
+				return outputs, nil
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, fmt.Errorf("jq program exceeded the execution time limit of %s", JQExecutionTimeout)
+			}
+			return nil, fmt.Errorf("jq program failed: %w", err)
+		}
+
+		encoded, err := gojq.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("jq program produced a value that cannot be encoded: %w", err)
+		}
+		outputSize += len(encoded)
+		if outputSize > JQMaxOutputSize {
+			return nil, fmt.Errorf("jq program output exceeds the maximum size of %d bytes", JQMaxOutputSize)
+		}
+		document, err := parseJSONDocument(string(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("jq program produced invalid JSON: %w", err)
+		}
+		outputs = append(outputs, document)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for common jq language features.
+func TestJQFunction_Language(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = jsonencode({
+						services = [
+							{ name = "api", env = "prod", replicas = 3 },
+							{ name = "worker", env = "prod", replicas = 2 },
+							{ name = "web", env = "dev", replicas = 1 },
+						]
+						labels = { team = "platform", tier = "backend" }
+					})
+				}
+				output "test_path" {
+					value = provider::prettyjson::jq(local.doc, ".services[0].name", null)
+				}
+				output "test_select_map" {
+					value = provider::prettyjson::jq(local.doc, "[.services[] | select(.env == \"prod\")] | map(.name)", null)
+				}
+				output "test_to_entries" {
+					value = provider::prettyjson::jq(local.doc, ".labels | to_entries | map(\"\\(.key)=\\(.value)\") | join(\",\")", null)
+				}
+				output "test_reduce" {
+					value = provider::prettyjson::jq(local.doc, "reduce .services[] as $s (0; . + $s.replicas)", null)
+				}
+				output "test_object_construction" {
+					value = provider::prettyjson::jq(local.doc, ".services | map({(.name): .replicas}) | add", null)
+				}
+				output "test_big_integer" {
+					value = provider::prettyjson::jq("{\"id\":12345678901234567890}", ".id", null)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_path", "\"api\""),
+					resource.TestCheckOutput("test_select_map", "[\n  \"api\",\n  \"worker\"\n]"),
+					resource.TestCheckOutput("test_to_entries", "\"team=platform,tier=backend\""),
+					resource.TestCheckOutput("test_reduce", "6"),
+					resource.TestCheckOutput("test_object_construction", "{\n  \"api\": 3,\n  \"web\": 1,\n  \"worker\": 2\n}"),
+					resource.TestCheckOutput("test_big_integer", "12345678901234567890"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for variables and output modes.
+func TestJQFunction_ArgsAndOutputs(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = jsonencode({ services = [{ name = "api", replicas = 3 }, { name = "web", replicas = 1 }] })
+				}
+				output "test_string_arg" {
+					value = provider::prettyjson::jq(local.doc, "\"\\($prefix)-\\(.services[0].name)\"", { prefix = "prod" })
+				}
+				output "test_number_arg" {
+					value = provider::prettyjson::jq(local.doc, "[.services[] | select(.replicas >= $min) | .name]", { min = 2 })
+				}
+				output "test_named_args" {
+					value = provider::prettyjson::jq("null", "$ARGS.named", { b = [1, 2], a = true }, { indentation_type = "tab" })
+				}
+				output "test_array_output" {
+					value = provider::prettyjson::jq(local.doc, ".services[].name", null, { output = "array" })
+				}
+				output "test_empty_array_output" {
+					value = provider::prettyjson::jq(local.doc, "empty", null, { output = "array" })
+				}
+				output "test_env_is_empty" {
+					value = provider::prettyjson::jq("null", "$ENV | length", null)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_string_arg", "\"prod-api\""),
+					resource.TestCheckOutput("test_number_arg", "[\n  \"api\"\n]"),
+					resource.TestCheckOutput("test_named_args", "{\n\t\"a\": true,\n\t\"b\": [\n\t\t1,\n\t\t2\n\t]\n}"),
+					resource.TestCheckOutput("test_array_output", "[\n  \"api\",\n  \"web\"\n]"),
+					resource.TestCheckOutput("test_empty_array_output", "[]"),
+					resource.TestCheckOutput("test_env_is_empty", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJQFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_parse_error" {
+					value = provider::prettyjson::jq("{}", ".a | ", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"program"\s+parameter:\s+Invalid\s+jq\s+program\s+at\s+position\s+\d+:\s+unexpected\s+EOF`),
+			},
+			{
+				Config: `
+				output "test_undefined_variable" {
+					value = provider::prettyjson::jq("{}", "$missing", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`variable\s+not\s+defined:\s+\$missing`),
+			},
+			{
+				Config: `
+				output "test_runtime_error" {
+					value = provider::prettyjson::jq("{\"a\":\"x\"}", ".a + 1", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`jq\s+program\s+failed:\s+cannot\s+add`),
+			},
+			{
+				Config: `
+				output "test_multiple_outputs" {
+					value = provider::prettyjson::jq("[1,2]", ".[]", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`jq\s+program\s+produced\s+2\s+outputs,\s+expected\s+exactly\s+one`),
+			},
+			{
+				Config: `
+				output "test_input_not_allowed" {
+					value = provider::prettyjson::jq("{}", "input", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+jq\s+program`),
+			},
+			{
+				Config: `
+				output "test_invalid_variable_name" {
+					value = provider::prettyjson::jq("{}", ".", { "my-var" = 1 })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+variable\s+name\s+"my-var"`),
+			},
+			{
+				Config: `
+				output "test_args_not_object" {
+					value = provider::prettyjson::jq("{}", ".", "x")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Args\s+must\s+be\s+an\s+object,\s+got\s+string`),
+			},
+		},
+	})
+}
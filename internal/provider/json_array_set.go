@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Occurrence kept by the array set functions when elements are duplicates.
+const (
+	ArraySetKeepFirst = "first"
+	ArraySetKeepLast  = "last"
+)
+
+// arraySetOptionsDescription documents the options shared by the array set
+// functions.
+const arraySetOptionsDescription = "Optional object with options:\n\n" +
+	"- `key` - Identity key for arrays of objects: a member name such as `\"Sid\"`, a JSON Pointer such as `\"/metadata/name\"`, or a list of them for composite keys\n" +
+	"- `keep` - `\"first\"` (default) or `\"last\"`: which of several elements with the same identity is kept. The kept element takes the position of the first one.\n" +
+	"- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n" +
+	"**Example:**\n`{ key = [\"protocol\", \"from_port\", \"to_port\"], keep = \"last\" }`"
+
+// arraySetIdentityDescription documents how array set functions compare
+// elements.
+const arraySetIdentityDescription = `## Identity
+
+Elements are compared with deep structural equality: object member order is ignored and numbers are compared by value, so ` + "`{\"a\":1,\"b\":2}`" + ` and ` + "`{\"b\":2,\"a\":1.0}`" + ` are the same element. With the ` + "`key`" + ` option, objects are identified by the values of the key members only, which is how policy statements (` + "`Sid`" + `) or security group rules (protocol and ports) are usually matched. Elements that lack a key member are compared as whole values.
+
+The result keeps the order in which elements first appear and never contains two elements with the same identity.`
+
+// jsonArraySet implements set operations on JSON arrays.
+type jsonArraySet struct {
+	key  []jsonPointer
+	keep string
+}
+
+// parseArraySetOptions decodes the options shared by the array set functions.
+func parseArraySetOptions(ctx context.Context, options []types.Dynamic, argumentPosition int64) (jsonArraySet, string, *function.FuncError) {
+	set := jsonArraySet{}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, argumentPosition, "key", "keep", "indentation_type")
+	if funcErr != nil {
+		return set, "", funcErr
+	}
+
+	set.keep, funcErr = opts.stringOption("keep", ArraySetKeepFirst, ArraySetKeepFirst, ArraySetKeepLast)
+	if funcErr != nil {
+		return set, "", funcErr
+	}
+
+	var keys []string
+	if value, ok := opts.values.Get("key"); ok && value != nil {
+		if single, isString := value.(string); isString {
+			keys = []string{single}
+		} else if keys, ok = stringList(value); !ok || len(keys) == 0 {
+			return set, "", function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+				"Option \"key\" must be a string or a non-empty list of strings, got %s.", jsonTypeName(value)))
+		}
+	}
+	for _, key := range keys {
+		pointer, err := parseMemberPointer(key)
+		if err != nil {
+			return set, "", function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid option \"key\": %v.", err))
+		}
+		set.key = append(set.key, pointer)
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		return set, "", funcErr
+	}
+	return set, indent, nil
+}
+
+// parseJSONArrayArgument parses a document that must be a JSON array.
+func parseJSONArrayArgument(ctx context.Context, s string, argumentPosition int64) ([]any, *function.FuncError) {
+	document, funcErr := parseJSONArgument(ctx, s, argumentPosition)
+	if funcErr != nil {
+		return nil, funcErr
+	}
+	array, ok := document.([]any)
+	if !ok {
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"Document must be a JSON array, got %s.", jsonTypeName(document)))
+	}
+	return array, nil
+}
+
+// parseJSONArrayListArgument parses a list of documents that must all be JSON
+// arrays.
+func parseJSONArrayListArgument(ctx context.Context, documents []string, argumentPosition int64) ([][]any, *function.FuncError) {
+	if len(documents) == 0 {
+		return nil, function.NewArgumentFuncError(argumentPosition, "At least one JSON array must be provided.")
+	}
+	arrays := make([][]any, len(documents))
+	for i, document := range documents {
+		array, funcErr := parseJSONArrayArgument(ctx, document, argumentPosition)
+		if funcErr != nil {
+			return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Document at index %d: %s", i, funcErr.Text))
+		}
+		arrays[i] = array
+	}
+	return arrays, nil
+}
+
+// identity returns the value an element is compared by: the list of key
+// values when all key members are present, otherwise the element itself.
+func (s jsonArraySet) identity(element any) (any, bool) {
+	if len(s.key) == 0 {
+		return element, false
+	}
+	if _, isObject := element.(*jsonObject); !isObject {
+		return element, false
+	}
+	values := make([]any, len(s.key))
+	for i, pointer := range s.key {
+		value, err := pointer.Resolve(element)
+		if err != nil {
+			return element, false
+		}
+		values[i] = value
+	}
+	return values, true
+}
+
+// same reports whether two elements have the same identity.
+func (s jsonArraySet) same(a, b any) bool {
+	aIdentity, aKeyed := s.identity(a)
+	bIdentity, bKeyed := s.identity(b)
+	return aKeyed == bKeyed && jsonValuesEqual(aIdentity, bIdentity)
+}
+
+// contains reports whether array holds an element with the identity of
+// element.
+func (s jsonArraySet) contains(array []any, element any) bool {
+	for _, candidate := range array {
+		if s.same(candidate, element) {
+			return true
+		}
+	}
+	return false
+}
+
+// dedupe returns the distinct elements of array in order of first
+// appearance. With keep = "last" a later duplicate replaces the kept element.
+func (s jsonArraySet) dedupe(array []any) []any {
+	result := []any{}
+	for _, element := range array {
+		index := -1
+		for i, kept := range result {
+			if s.same(kept, element) {
+				index = i
+				break
+			}
+		}
+		switch {
+		case index < 0:
+			result = append(result, element)
+		case s.keep == ArraySetKeepLast:
+			result[index] = element
+		}
+	}
+	return result
+}
+
+// union returns the distinct elements of all arrays.
+func (s jsonArraySet) union(arrays [][]any) []any {
+	var all []any
+	for _, array := range arrays {
+		all = append(all, array...)
+	}
+	return s.dedupe(all)
+}
+
+// intersect returns the distinct elements of the first array that have a
+// match in every other array.
+func (s jsonArraySet) intersect(arrays [][]any) []any {
+	var common []any
+	for _, element := range arrays[0] {
+		inAll := true
+		for _, other := range arrays[1:] {
+			if !s.contains(other, element) {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			common = append(common, element)
+		}
+	}
+	return s.dedupe(common)
+}
+
+// subtract returns the distinct elements of array that have no match in
+// remove.
+func (s jsonArraySet) subtract(array, remove []any) []any {
+	var remaining []any
+	for _, element := range array {
+		if !s.contains(remove, element) {
+			remaining = append(remaining, element)
+		}
+	}
+	return s.dedupe(remaining)
+}
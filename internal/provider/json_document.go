@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// jsonObject is an insertion-ordered JSON object.
+//
+// Document manipulation functions decode into jsonObject instead of
+// map[string]any so that key order survives a round trip through the
+// provider. Numbers are kept as json.Number for the same reason: the
+// original literal is written back out unchanged.
+type jsonObject struct {
+	keys   []string
+	values map[string]any
+}
+
+func newJSONObject() *jsonObject {
+	return &jsonObject{values: map[string]any{}}
+}
+
+// Get returns the value stored under key.
+func (o *jsonObject) Get(key string) (any, bool) {
+	value, ok := o.values[key]
+	return value, ok
+}
+
+// Set stores value under key. New keys are appended to the end of the
+// object, existing keys keep their position.
+func (o *jsonObject) Set(key string, value any) {
+	if _, exists := o.values[key]; !exists {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// Delete removes key from the object and reports whether it was present.
+func (o *jsonObject) Delete(key string) bool {
+	if _, exists := o.values[key]; !exists {
+		return false
+	}
+	delete(o.values, key)
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// Keys returns the object keys in document order.
+func (o *jsonObject) Keys() []string {
+	return o.keys
+}
+
+// Len returns the number of members in the object.
+func (o *jsonObject) Len() int {
+	return len(o.keys)
+}
+
+// MarshalJSON writes the object members in document order.
+func (o *jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		encodedValue, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedValue)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// parseJSONDocument decodes a JSON text into the ordered document model.
+//
+// The result is built from nil, bool, string, json.Number, []any and
+// *jsonObject values. Duplicate object keys keep the position of their first
+// occurrence and the value of their last, matching encoding/json.
+func parseJSONDocument(data string) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+
+	value, err := decodeJSONValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unexpected data after top-level value at offset %d", decoder.InputOffset())
+	}
+
+	return value, nil
+}
+
+func decodeJSONValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			object := newJSONObject()
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, ok := keyToken.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected object key at offset %d", decoder.InputOffset())
+				}
+				value, err := decodeJSONValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				object.Set(key, value)
+			}
+			if _, err := decoder.Token(); err != nil {
+				return nil, err
+			}
+			return object, nil
+		case '[':
+			array := []any{}
+			for decoder.More() {
+				value, err := decodeJSONValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, value)
+			}
+			if _, err := decoder.Token(); err != nil {
+				return nil, err
+			}
+			return array, nil
+		default:
+			return nil, fmt.Errorf("unexpected delimiter %q at offset %d", t, decoder.InputOffset())
+		}
+	default:
+		// nil, bool, string and json.Number are already in their final form.
+		return t, nil
+	}
+}
+
+// formatJSONDocument renders a document with the given indentation string,
+// using the same encoder settings as jsonprettyprint.
+func formatJSONDocument(value any, indent string) (string, error) {
+	formatted, err := json.MarshalIndent(value, "", indent)
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// cloneJSONValue returns a deep copy of a document value.
+func cloneJSONValue(value any) any {
+	switch v := value.(type) {
+	case *jsonObject:
+		clone := &jsonObject{
+			keys:   make([]string, len(v.keys)),
+			values: make(map[string]any, len(v.values)),
+		}
+		copy(clone.keys, v.keys)
+		for key, member := range v.values {
+			clone.values[key] = cloneJSONValue(member)
+		}
+		return clone
+	case []any:
+		clone := make([]any, len(v))
+		for i, element := range v {
+			clone[i] = cloneJSONValue(element)
+		}
+		return clone
+	default:
+		return v
+	}
+}
+
+// jsonValueToGeneric converts a document value into the generic
+// encoding/json representation expected by third-party query engines.
+// Objects become maps, so member order is lost; numbers stay json.Number.
+func jsonValueToGeneric(value any) any {
+	switch v := value.(type) {
+	case *jsonObject:
+		generic := make(map[string]any, len(v.values))
+		for key, member := range v.values {
+			generic[key] = jsonValueToGeneric(member)
+		}
+		return generic
+	case []any:
+		generic := make([]any, len(v))
+		for i, element := range v {
+			generic[i] = jsonValueToGeneric(element)
+		}
+		return generic
+	default:
+		return v
+	}
+}
+
+// jsonValuesEqual reports whether two document values are structurally
+// equal. Object member order is ignored and numbers are compared by value, so
+// 1, 1.0 and 1e0 are all equal.
+func jsonValuesEqual(a, b any) bool {
+	switch av := a.(type) {
+	case nil:
+		return b == nil
+	case bool:
+		bv, ok := b.(bool)
+		return ok && av == bv
+	case string:
+		bv, ok := b.(string)
+		return ok && av == bv
+	case json.Number:
+		bv, ok := b.(json.Number)
+		return ok && jsonNumbersEqual(av, bv)
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonValuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case *jsonObject:
+		bv, ok := b.(*jsonObject)
+		if !ok || av.Len() != bv.Len() {
+			return false
+		}
+		for _, key := range av.keys {
+			other, exists := bv.values[key]
+			if !exists || !jsonValuesEqual(av.values[key], other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func jsonNumbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	ar, aok := new(big.Rat).SetString(a.String())
+	br, bok := new(big.Rat).SetString(b.String())
+	if !aok || !bok {
+		return false
+	}
+	return ar.Cmp(br) == 0
+}
+
+// jsonTypeName returns the JSON type name of a document value for use in
+// error messages.
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case []any:
+		return "array"
+	case *jsonObject:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
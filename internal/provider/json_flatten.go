@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Flattened paths join object keys with a separator and write array indices
+// in brackets, for example a.b[0].c. Within keys, a backslash escapes every
+// occurrence of the first character of the separator, '[', ']' and itself,
+// so that a separator can only start outside of keys: every document has
+// exactly one flattened form and keys containing the separator, or part of
+// it, survive a round trip.
+
+var jsonNumberPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// flatPathElement is an object key or an array index of a flattened path.
+type flatPathElement struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// validateFlattenSeparator checks that a separator can be escaped
+// unambiguously.
+func validateFlattenSeparator(separator string) error {
+	if separator == "" {
+		return fmt.Errorf("separator must not be empty")
+	}
+	if strings.ContainsAny(separator, `\[]`) {
+		return fmt.Errorf("separator %q must not contain '\\', '[' or ']'", separator)
+	}
+	return nil
+}
+
+// escapeFlattenedKey escapes an object key for use in a flattened path.
+// Escaping every occurrence of the first character of the separator rather
+// than only whole separators also covers keys that end with the start of the
+// separator or start with its end, such as "a_" and "_b" with the separator
+// "__".
+func escapeFlattenedKey(key, separator string) string {
+	first, _ := utf8.DecodeRuneInString(separator)
+	special := func(r rune) bool {
+		return r == '\\' || r == '[' || r == ']' || r == first
+	}
+	if strings.IndexFunc(key, special) < 0 {
+		return key
+	}
+	var b strings.Builder
+	for _, r := range key {
+		if special(r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// appendFlattenedKey returns the path of an object member of path.
+func appendFlattenedKey(path, key, separator string, root bool) string {
+	if root {
+		return escapeFlattenedKey(key, separator)
+	}
+	return path + separator + escapeFlattenedKey(key, separator)
+}
+
+// walkFlattened calls visit for every leaf of a document, in document order.
+// Scalars and empty containers are leaves. The document itself must be an
+// object or an array.
+func walkFlattened(value any, separator string, visit func(path string, leaf any) error) error {
+	switch value.(type) {
+	case *jsonObject, []any:
+	default:
+		return fmt.Errorf("only objects and arrays can be flattened, got %s", jsonTypeName(value))
+	}
+	return walkFlattenedValue(value, "", separator, true, visit)
+}
+
+func walkFlattenedValue(value any, path, separator string, root bool, visit func(path string, leaf any) error) error {
+	switch v := value.(type) {
+	case *jsonObject:
+		if v.Len() == 0 && !root {
+			return visit(path, v)
+		}
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			// The paths of an array under an empty top-level key would
+			// start with an index, as those of a top-level array do.
+			if array, ok := member.([]any); ok && root && key == "" && len(array) > 0 {
+				return fmt.Errorf("the empty key at the top level holds an array, whose paths could not be told apart from those of a top-level array")
+			}
+			if err := walkFlattenedValue(member, appendFlattenedKey(path, key, separator, root), separator, false, visit); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		if len(v) == 0 && !root {
+			return visit(path, v)
+		}
+		for i, element := range v {
+			if err := walkFlattenedValue(element, path+"["+strconv.Itoa(i)+"]", separator, false, visit); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return visit(path, v)
+	}
+}
+
+// flattenedLeafString returns the string form of a leaf. Strings are
+// returned as they are, all other leaves as their JSON text.
+func flattenedLeafString(leaf any) string {
+	switch v := leaf.(type) {
+	case string:
+		return v
+	case json.Number:
+		return string(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return "null"
+	case []any:
+		return "[]"
+	default:
+		return "{}"
+	}
+}
+
+// parseFlattenedPath splits a flattened path into keys and indices.
+func parseFlattenedPath(path, separator string) ([]flatPathElement, error) {
+	var elements []flatPathElement
+	i := 0
+	expectKey := !strings.HasPrefix(path, "[")
+	for {
+		if expectKey {
+			var key strings.Builder
+			for i < len(path) && path[i] != '[' && !strings.HasPrefix(path[i:], separator) {
+				if path[i] != '\\' {
+					key.WriteByte(path[i])
+					i++
+					continue
+				}
+				if i+1 == len(path) {
+					return nil, fmt.Errorf("path %q ends with an incomplete escape sequence", path)
+				}
+				key.WriteByte(path[i+1])
+				i += 2
+			}
+			elements = append(elements, flatPathElement{key: key.String()})
+		}
+
+		switch {
+		case i == len(path):
+			return elements, nil
+		case strings.HasPrefix(path[i:], separator):
+			i += len(separator)
+			expectKey = true
+		case path[i] == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("path %q has an unterminated array index at offset %d", path, i)
+			}
+			digits := path[i+1 : i+end]
+			index, err := strconv.Atoi(digits)
+			if err != nil || index < 0 || strconv.Itoa(index) != digits {
+				return nil, fmt.Errorf("path %q has an invalid array index %q at offset %d", path, digits, i)
+			}
+			elements = append(elements, flatPathElement{index: index, isIndex: true})
+			i += end + 1
+			expectKey = false
+		default:
+			return nil, fmt.Errorf("path %q has an unexpected character %q at offset %d, expected the separator or '['", path, path[i], i)
+		}
+	}
+}
+
+// flatNode is an intermediate tree node used while unflattening, so that
+// conflicts and gaps in arrays can be reported with the paths involved.
+type flatNode struct {
+	origin   string
+	leaf     any
+	isLeaf   bool
+	keys     []string
+	members  map[string]*flatNode
+	elements map[int]*flatNode
+}
+
+func (n *flatNode) isArray() bool {
+	return n.elements != nil
+}
+
+// unflattenJSON rebuilds a document from flattened paths. With inferTypes,
+// values that are JSON scalars or empty containers are decoded; otherwise
+// every leaf is a string.
+func unflattenJSON(entries map[string]string, separator string, inferTypes bool) (any, error) {
+	paths := make([]string, 0, len(entries))
+	for path := range entries {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var root *flatNode
+	for _, path := range paths {
+		elements, err := parseFlattenedPath(path, separator)
+		if err != nil {
+			return nil, err
+		}
+		if root == nil {
+			root = newFlatContainer(path, elements[0])
+		}
+
+		node := root
+		for depth, element := range elements {
+			if node.isLeaf || node.isArray() != element.isIndex {
+				return nil, fmt.Errorf("path %q conflicts with path %q", path, node.origin)
+			}
+			child := node.child(element)
+			last := depth == len(elements)-1
+			if child == nil {
+				if last {
+					child = &flatNode{origin: path, isLeaf: true, leaf: flattenedLeafValue(entries[path], inferTypes)}
+				} else {
+					child = newFlatContainer(path, elements[depth+1])
+				}
+				node.setChild(element, child)
+			} else if last {
+				return nil, fmt.Errorf("path %q conflicts with path %q", path, child.origin)
+			}
+			node = child
+		}
+	}
+
+	if root == nil {
+		return newJSONObject(), nil
+	}
+	return root.value()
+}
+
+func newFlatContainer(origin string, next flatPathElement) *flatNode {
+	if next.isIndex {
+		return &flatNode{origin: origin, elements: map[int]*flatNode{}}
+	}
+	return &flatNode{origin: origin, members: map[string]*flatNode{}}
+}
+
+func (n *flatNode) child(element flatPathElement) *flatNode {
+	if element.isIndex {
+		return n.elements[element.index]
+	}
+	return n.members[element.key]
+}
+
+func (n *flatNode) setChild(element flatPathElement, child *flatNode) {
+	if element.isIndex {
+		n.elements[element.index] = child
+		return
+	}
+	n.keys = append(n.keys, element.key)
+	n.members[element.key] = child
+}
+
+func (n *flatNode) value() (any, error) {
+	if n.isLeaf {
+		return n.leaf, nil
+	}
+	if n.isArray() {
+		array := make([]any, len(n.elements))
+		for i := range array {
+			element, ok := n.elements[i]
+			if !ok {
+				return nil, fmt.Errorf("array index %d is missing, indices must be contiguous from 0 (see path %q)", i, n.origin)
+			}
+			value, err := element.value()
+			if err != nil {
+				return nil, err
+			}
+			array[i] = value
+		}
+		return array, nil
+	}
+	object := newJSONObject()
+	for _, key := range n.keys {
+		value, err := n.members[key].value()
+		if err != nil {
+			return nil, err
+		}
+		object.Set(key, value)
+	}
+	return object, nil
+}
+
+// flattenedLeafValue is the inverse of flattenedLeafString.
+func flattenedLeafValue(s string, inferTypes bool) any {
+	if !inferTypes {
+		return s
+	}
+	switch s {
+	case "null":
+		return nil
+	case "true":
+		return true
+	case "false":
+		return false
+	case "{}":
+		return newJSONObject()
+	case "[]":
+		return []any{}
+	}
+	if jsonNumberPattern.MatchString(s) {
+		return json.Number(s)
+	}
+	return s
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Path patterns select locations in a document. A pattern is either a JSON
+// Pointer (empty or starting with '/') or a glob-style path such as
+// metadata.managedFields, spec.containers[*].image or **.creationTimestamp:
+//
+//   - segments are separated by '.', array indices may also be written in
+//     brackets right after the preceding segment, as in a[0]
+//   - '*' matches any part of a key, '?' matches a single character, both
+//     including line breaks
+//   - a '**' segment matches any number of levels, including none
+//   - a backslash escapes '.', '[', ']', '*', '?' and itself
+type jsonPathPattern []jsonPathPatternSegment
+
+type jsonPathPatternSegment struct {
+	literal  string
+	wildcard *regexp.Regexp
+	anyDepth bool
+}
+
+func (s jsonPathPatternSegment) matches(token string) bool {
+	if s.wildcard != nil {
+		return s.wildcard.MatchString(token)
+	}
+	return s.literal == token
+}
+
+// parseJSONPathPattern parses a JSON Pointer or glob-style path.
+func parseJSONPathPattern(pattern string) (jsonPathPattern, error) {
+	if pattern == "" || strings.HasPrefix(pattern, "/") {
+		pointer, err := parseJSONPointer(pattern)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathPatternFromPointer(pointer), nil
+	}
+
+	if !utf8.ValidString(pattern) {
+		return nil, fmt.Errorf("glob %q is not valid UTF-8", pattern)
+	}
+
+	var segments jsonPathPattern
+	var literal, expression strings.Builder
+	// hasEscape tells an escaped star apart from a wildcard in the literal,
+	// so that \** is a wildcard segment rather than the recursive **.
+	hasWildcard, hasEscape := false, false
+	flush := func() error {
+		segment := jsonPathPatternSegment{literal: literal.String()}
+		if segment.literal == "**" && hasWildcard && !hasEscape {
+			segment = jsonPathPatternSegment{anyDepth: true}
+		} else if hasWildcard {
+			// (?s) lets wildcards match keys holding line breaks.
+			wildcard, err := regexp.Compile("(?s)^" + expression.String() + "$")
+			if err != nil {
+				return fmt.Errorf("glob %q: %w", pattern, err)
+			}
+			segment.wildcard = wildcard
+		}
+		segments = append(segments, segment)
+		literal.Reset()
+		expression.Reset()
+		hasWildcard, hasEscape = false, false
+		return nil
+	}
+
+	afterIndex, afterDot := false, false
+	for i := 0; i < len(pattern); {
+		r, size := utf8.DecodeRuneInString(pattern[i:])
+		switch {
+		case afterIndex && r != '.' && r != '[':
+			return nil, fmt.Errorf("glob %q has an unexpected character %q at offset %d, expected '.' or '['", pattern, r, i)
+		case r == '\\':
+			if i+size == len(pattern) {
+				return nil, fmt.Errorf("glob %q ends with an incomplete escape sequence", pattern)
+			}
+			escaped, escapedSize := utf8.DecodeRuneInString(pattern[i+size:])
+			hasEscape = true
+			literal.WriteRune(escaped)
+			expression.WriteString(regexp.QuoteMeta(string(escaped)))
+			size += escapedSize
+		case r == '.':
+			if !afterIndex {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			afterIndex = false
+			if i+size == len(pattern) {
+				return nil, fmt.Errorf("glob %q must not end with '.'", pattern)
+			}
+			i += size
+			afterDot = true
+			continue
+		case r == '[':
+			if afterDot {
+				return nil, fmt.Errorf("glob %q has an array index after '.' at offset %d, write it as a[0]", pattern, i)
+			}
+			if i > 0 && !afterIndex {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("glob %q has an unterminated array index at offset %d", pattern, i)
+			}
+			index := pattern[i+1 : i+end]
+			if index != "*" && (index == "" || strings.Trim(index, "0123456789") != "") {
+				return nil, fmt.Errorf("glob %q has an invalid array index %q at offset %d, expected a number or '*'", pattern, index, i)
+			}
+			if index == "*" {
+				segments = append(segments, jsonPathPatternSegment{wildcard: regexp.MustCompile(`^[0-9]+$`)})
+			} else {
+				segments = append(segments, jsonPathPatternSegment{literal: index})
+			}
+			i += end + 1
+			afterIndex = true
+			continue
+		case r == ']':
+			return nil, fmt.Errorf("glob %q has an unexpected ']' at offset %d", pattern, i)
+		case r == '*':
+			hasWildcard = true
+			literal.WriteRune(r)
+			expression.WriteString(".*")
+		case r == '?':
+			hasWildcard = true
+			literal.WriteRune(r)
+			expression.WriteString(".")
+		default:
+			literal.WriteRune(r)
+			expression.WriteString(regexp.QuoteMeta(string(r)))
+		}
+		i += size
+		afterDot = false
+	}
+	if !afterIndex {
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	return segments, nil
+}
+
+// jsonPathPatternFromPointer returns a pattern that matches exactly the
+// location of a JSON Pointer.
+func jsonPathPatternFromPointer(pointer jsonPointer) jsonPathPattern {
+	segments := make(jsonPathPattern, len(pointer))
+	for i, token := range pointer {
+		segments[i] = jsonPathPatternSegment{literal: token}
+	}
+	return segments
+}
+
+// Matches reports whether the pattern matches the location exactly.
+func (p jsonPathPattern) Matches(location jsonPointer) bool {
+	return p.match(location, false)
+}
+
+// MayMatchBelow reports whether the pattern can match the location or a
+// location below it.
+func (p jsonPathPattern) MayMatchBelow(location jsonPointer) bool {
+	return p.match(location, true)
+}
+
+func (p jsonPathPattern) match(tokens []string, partial bool) bool {
+	if len(p) == 0 {
+		return len(tokens) == 0
+	}
+	if p[0].anyDepth {
+		return p[1:].match(tokens, partial) || (len(tokens) > 0 && p.match(tokens[1:], partial))
+	}
+	if len(tokens) == 0 {
+		return partial
+	}
+	return p[0].matches(tokens[0]) && p[1:].match(tokens[1:], partial)
+}
+
+// jsonPathPatterns is a set of path patterns.
+type jsonPathPatterns []jsonPathPattern
+
+// parseJSONPathPatterns parses a list of path patterns.
+func parseJSONPathPatterns(patterns []string) (jsonPathPatterns, error) {
+	parsed := make(jsonPathPatterns, len(patterns))
+	for i, pattern := range patterns {
+		p, err := parseJSONPathPattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("path at index %d: %w", i, err)
+		}
+		parsed[i] = p
+	}
+	return parsed, nil
+}
+
+// Matches reports whether any pattern matches the location exactly.
+func (ps jsonPathPatterns) Matches(location jsonPointer) bool {
+	for _, p := range ps {
+		if p.Matches(location) {
+			return true
+		}
+	}
+	return false
+}
+
+// MayMatchBelow reports whether any pattern can match the location or a
+// location below it.
+func (ps jsonPathPatterns) MayMatchBelow(location jsonPointer) bool {
+	for _, p := range ps {
+		if p.MayMatchBelow(location) {
+			return true
+		}
+	}
+	return false
+}
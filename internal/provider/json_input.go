@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultIndentationType is used when a function's indentation_type argument
+// is omitted.
+const DefaultIndentationType = "2spaces"
+
+// validIndentationTypes lists the accepted indentation_type values in the
+// order they are presented to users.
+var validIndentationTypes = []string{"2spaces", "4spaces", "tab"}
+
+// IndentationTypePreserve makes jsonprettyprint detect the indentation of its
+// input and keep it.
+const IndentationTypePreserve = "preserve"
+
+// indentationTypeArgument returns the optional variadic indentation_type
+// argument, falling back to DefaultIndentationType.
+func indentationTypeArgument(ctx context.Context, indentationTypes []string) string {
+	if len(indentationTypes) > 0 {
+		tflog.Debug(ctx, "Using provided indentation type", map[string]any{
+			"indentation_type": indentationTypes[0],
+		})
+		return indentationTypes[0]
+	}
+
+	tflog.Debug(ctx, "Using default indentation type", map[string]any{
+		"default_indentation": DefaultIndentationType,
+	})
+	return DefaultIndentationType
+}
+
+// resolveIndentation maps an indentation type name to the indent string used
+// by the encoder. argumentPosition identifies the parameter that supplied the
+// value in error responses.
+func resolveIndentation(ctx context.Context, indentationType string, argumentPosition int64) (string, *function.FuncError) {
+	switch indentationType {
+	case "2spaces":
+		return "  ", nil
+	case "4spaces":
+		return "    ", nil
+	case "tab":
+		return "\t", nil
+	case "":
+		// Default to 2 spaces when no indentation type specified
+		tflog.Debug(ctx, "Using default indentation (no type specified)", map[string]any{
+			"default_indent": DefaultIndentationType,
+		})
+		return "  ", nil
+	default:
+		// Task 7: Explicit validation with descriptive error messages for invalid indentation types
+		tflog.Error(ctx, "Invalid indentation type provided", map[string]any{
+			"error_type":    ErrorTypeValidation,
+			"error_code":    "INVALID_INDENTATION_TYPE",
+			"provided_type": indentationType,
+			"valid_types":   validIndentationTypes,
+		})
+
+		return "", function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"Invalid indentation type '%s'. Valid options are: '2spaces', '4spaces', or 'tab'. "+
+				"Please specify one of the supported indentation types for proper JSON formatting.",
+			indentationType))
+	}
+}
+
+// detectIndentation returns the indent unit of a formatted JSON document: a
+// tab if most indented lines start with one, otherwise the most common
+// increase in leading spaces from one line to the next. It reports false
+// for documents without indented lines, such as minified ones. JSON strings
+// cannot contain line breaks, so every line break is outside of strings.
+func detectIndentation(data string) (string, bool) {
+	tabLines, spaceLines := 0, 0
+	steps := map[int]int{}
+	previous := -1
+	for _, line := range strings.Split(data, "\n") {
+		content := strings.TrimLeft(line, " \t")
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+		lead := line[:len(line)-len(content)]
+		switch {
+		case strings.HasPrefix(lead, "\t"):
+			tabLines++
+			previous = -1
+			continue
+		case lead != "":
+			spaceLines++
+		}
+		if width := len(lead); previous >= 0 && width > previous && width-previous <= maxDetectedIndentation {
+			steps[width-previous]++
+		}
+		previous = len(lead)
+	}
+
+	if tabLines > 0 && tabLines >= spaceLines {
+		return "\t", true
+	}
+	unit := 0
+	for step, count := range steps {
+		if unit == 0 || count > steps[unit] || count == steps[unit] && step < unit {
+			unit = step
+		}
+	}
+	if unit == 0 {
+		return "", false
+	}
+	return strings.Repeat(" ", unit), true
+}
+
+// maxDetectedIndentation is the widest indent unit detectIndentation accepts.
+const maxDetectedIndentation = 8
+
+// validateJSONInput performs the empty input, size limit and syntax checks
+// shared by every function that accepts a JSON string argument.
+func validateJSONInput(ctx context.Context, jsonString string, argumentPosition int64) *function.FuncError {
+	if funcErr := validateInputSize(ctx, jsonString, "JSON", argumentPosition); funcErr != nil {
+		return funcErr
+	}
+	inputSize := len(jsonString)
+
+	// Task 4.1: JSON Validation Logic
+	tflog.Debug(ctx, "Starting JSON validation", map[string]any{
+		"input_size":        inputSize,
+		"argument_position": argumentPosition,
+	})
+
+	// Fast JSON validation using json.Valid()
+	validationStart := time.Now()
+	if !json.Valid([]byte(jsonString)) {
+		// Task 5.4: Context-aware error messages with remediation suggestions
+		tflog.Error(ctx, "JSON validation failed", map[string]any{
+			"error_type":         ErrorTypeValidation,
+			"error_code":         "INVALID_JSON_SYNTAX",
+			"argument_position":  argumentPosition,
+			"input_preview":      truncateString(jsonString, 100),
+			"validation_time_ms": time.Since(validationStart).Milliseconds(),
+			"input_size":         inputSize,
+		})
+
+		// Enhanced error message with context and remediation suggestions
+		return function.NewArgumentFuncError(argumentPosition,
+			"Invalid JSON syntax detected. Common issues include: "+
+				"missing quotes around strings, trailing commas, unescaped characters, "+
+				"or mismatched brackets/braces. Please validate your JSON using a JSON "+
+				"validator tool and ensure proper formatting.")
+	}
+
+	tflog.Debug(ctx, "JSON validation successful", map[string]any{
+		"validation_time_ms": time.Since(validationStart).Milliseconds(),
+		"argument_position":  argumentPosition,
+	})
+	return nil
+}
+
+// validateInputSize performs the empty input and size limit checks shared by
+// every function that accepts a document argument. format names the expected
+// document format in messages.
+func validateInputSize(ctx context.Context, input, format string, argumentPosition int64) *function.FuncError {
+	inputSize := len(input)
+
+	// Task 5.5: Performance monitoring - Log warning for large inputs
+	if inputSize > LargeJSONWarningSize {
+		tflog.Warn(ctx, "Large input detected", map[string]any{
+			"format":            format,
+			"warning_type":      "PERFORMANCE_WARNING",
+			"argument_position": argumentPosition,
+			"size_bytes":        inputSize,
+			"size_mb":           float64(inputSize) / (1024 * 1024),
+			"threshold_mb":      LargeJSONWarningSize / (1024 * 1024),
+			"recommendation":    "Consider reducing input size for better performance",
+		})
+	}
+
+	// Task 5.1 & 5.3: Enhanced input validation with size limits and error classification
+	if inputSize == 0 {
+		tflog.Error(ctx, "Empty input provided", map[string]any{
+			"format":            format,
+			"error_type":        ErrorTypeValidation,
+			"error_code":        "EMPTY_INPUT",
+			"argument_position": argumentPosition,
+		})
+		return function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"%s input cannot be empty. Please provide a valid %s string.", format, format))
+	}
+
+	// Task 5.3: Enforce maximum input size limit
+	if inputSize > MaxJSONSize {
+		tflog.Error(ctx, "Input exceeds maximum size limit", map[string]any{
+			"format":            format,
+			"error_type":        ErrorTypeValidation,
+			"error_code":        "SIZE_LIMIT_EXCEEDED",
+			"argument_position": argumentPosition,
+			"input_size":        inputSize,
+			"max_size":          MaxJSONSize,
+			"size_limit_mb":     MaxJSONSize / (1024 * 1024),
+		})
+		return function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"%s input size (%d bytes) exceeds maximum allowed size of %d MB. "+
+				"Please reduce the %s size or split into smaller chunks.",
+			format, inputSize, MaxJSONSize/(1024*1024), format))
+	}
+	return nil
+}
+
+// parseJSONArgument validates a JSON string argument and decodes it into the
+// ordered document model used by the document manipulation functions.
+func parseJSONArgument(ctx context.Context, jsonString string, argumentPosition int64) (any, *function.FuncError) {
+	if funcErr := validateJSONInput(ctx, jsonString, argumentPosition); funcErr != nil {
+		return nil, funcErr
+	}
+
+	parseStart := time.Now()
+	document, err := parseJSONDocument(jsonString)
+	if err != nil {
+		// Task 5.1 & 5.4: Enhanced error classification and context-aware messages
+		tflog.Error(ctx, "JSON parsing failed", map[string]any{
+			"error_type":        ErrorTypeParsing,
+			"error_code":        "JSON_PARSE_ERROR",
+			"argument_position": argumentPosition,
+			"error":             err.Error(),
+			"input_preview":     truncateString(jsonString, 100),
+			"parse_time_ms":     time.Since(parseStart).Milliseconds(),
+			"input_size":        len(jsonString),
+		})
+
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"JSON parsing error: %v. This typically indicates structural issues in "+
+				"the JSON such as incorrect nesting, invalid escape sequences, or "+
+				"data type mismatches. Please check the JSON structure and syntax.", err))
+	}
+
+	tflog.Debug(ctx, "JSON parsing successful", map[string]any{
+		"parse_time_ms":     time.Since(parseStart).Milliseconds(),
+		"argument_position": argumentPosition,
+		"data_type":         jsonTypeName(document),
+	})
+	return document, nil
+}
+
+// formatJSONResult renders a document for a function result using the
+// provided indent string.
+func formatJSONResult(ctx context.Context, document any, indent string) (string, *function.FuncError) {
+	formatStart := time.Now()
+	result, err := formatJSONDocument(document, indent)
+	if err != nil {
+		// Task 5.1 & 5.4: Enhanced error classification for processing errors
+		tflog.Error(ctx, "JSON formatting failed", map[string]any{
+			"error_type":     ErrorTypeProcessing,
+			"error_code":     "JSON_FORMAT_ERROR",
+			"error":          err.Error(),
+			"format_time_ms": time.Since(formatStart).Milliseconds(),
+		})
+
+		return "", function.NewFuncError(fmt.Sprintf(
+			"JSON formatting failed: %v. This error usually occurs when the parsed "+
+				"JSON data contains unsupported types or circular references. "+
+				"Please verify the JSON data structure is valid for serialization.", err))
+	}
+
+	tflog.Debug(ctx, "JSON formatting successful", map[string]any{
+		"format_time_ms": time.Since(formatStart).Milliseconds(),
+		"output_size":    len(result),
+	})
+	return result, nil
+}
+
+// parseJSONPointerArgument parses a JSON Pointer string argument.
+func parseJSONPointerArgument(ctx context.Context, pointerString string, argumentPosition int64) (jsonPointer, *function.FuncError) {
+	pointer, err := parseJSONPointer(pointerString)
+	if err != nil {
+		tflog.Error(ctx, "Invalid JSON Pointer", map[string]any{
+			"error_type":        ErrorTypeValidation,
+			"error_code":        "INVALID_JSON_POINTER",
+			"argument_position": argumentPosition,
+			"error":             err.Error(),
+		})
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid JSON Pointer: %v.", err))
+	}
+	return pointer, nil
+}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Array handling modes of the key/value formats.
+const (
+	KeyValueArraysIndex  = "index"
+	KeyValueArraysReject = "reject"
+)
+
+var (
+	// envNamePattern matches portable environment variable names.
+	envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	// envPlainValuePattern matches values that need no quotes in a dotenv
+	// file.
+	envPlainValuePattern = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,=-]*$`)
+)
+
+// keyValueFlattener flattens a document into key/value pairs for the
+// dotenv, INI and properties formats.
+type keyValueFlattener struct {
+	separator    string
+	rejectArrays bool
+	// indexSeparator writes array indices after the separator, as in
+	// SERVERS_0, instead of in brackets, as in servers[0].
+	indexSeparator bool
+}
+
+// parseKeyValueFlattener reads the separator and arrays options.
+func parseKeyValueFlattener(opts functionOptions, defaultSeparator string, indexSeparator bool) (keyValueFlattener, *function.FuncError) {
+	flattener := keyValueFlattener{indexSeparator: indexSeparator}
+
+	separator, funcErr := opts.stringOption("separator", defaultSeparator)
+	if funcErr != nil {
+		return flattener, funcErr
+	}
+	if err := validateFlattenSeparator(separator); err != nil {
+		return flattener, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf("Invalid option \"separator\": %v.", err))
+	}
+	flattener.separator = separator
+
+	arrays, funcErr := opts.stringOption("arrays", KeyValueArraysIndex, KeyValueArraysIndex, KeyValueArraysReject)
+	if funcErr != nil {
+		return flattener, funcErr
+	}
+	flattener.rejectArrays = arrays == KeyValueArraysReject
+	return flattener, nil
+}
+
+// flatten calls visit for every leaf of object with its flattened key and
+// location. Keys are joined with the separator as they are, without the
+// escaping of jsonflatten.
+func (f keyValueFlattener) flatten(object *jsonObject, location jsonPointer, visit func(key string, location jsonPointer, leaf any) error) error {
+	return walkFlattened(object, f.separator, func(path string, leaf any) error {
+		elements, err := parseFlattenedPath(path, f.separator)
+		if err != nil {
+			return err
+		}
+
+		var key strings.Builder
+		leafLocation := location
+		for i, element := range elements {
+			if element.isIndex {
+				if f.rejectArrays {
+					return fmt.Errorf("array at %q is not allowed, set arrays = %q to index its elements", leafLocation.String(), KeyValueArraysIndex)
+				}
+				leafLocation = leafLocation.child(strconv.Itoa(element.index))
+				if f.indexSeparator {
+					fmt.Fprintf(&key, "%s%d", f.separator, element.index)
+				} else {
+					fmt.Fprintf(&key, "[%d]", element.index)
+				}
+				continue
+			}
+			if i > 0 {
+				key.WriteString(f.separator)
+			}
+			key.WriteString(element.key)
+			leafLocation = leafLocation.child(element.key)
+		}
+
+		if _, isArray := leaf.([]any); isArray && f.rejectArrays {
+			return fmt.Errorf("array at %q is not allowed, set arrays = %q to index its elements", leafLocation.String(), KeyValueArraysIndex)
+		}
+		return visit(key.String(), leafLocation, leaf)
+	})
+}
+
+// keyValueLeafString returns the text of a leaf: null is empty, other leaves
+// are written as in jsonflatten.
+func keyValueLeafString(leaf any) string {
+	if leaf == nil {
+		return ""
+	}
+	return flattenedLeafString(leaf)
+}
+
+// requireJSONObject checks that a document to be rendered is an object.
+func requireJSONObject(document any) (*jsonObject, error) {
+	object, ok := document.(*jsonObject)
+	if !ok {
+		return nil, fmt.Errorf("document must be a JSON object, got %s", jsonTypeName(document))
+	}
+	return object, nil
+}
+
+// envFormatter renders a document as a dotenv file.
+type envFormatter struct {
+	keyValueFlattener
+	prefix    string
+	uppercase bool
+	export    bool
+}
+
+// format writes one NAME=value line per leaf.
+func (e envFormatter) format(document any) (string, error) {
+	object, err := requireJSONObject(document)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	seen := map[string]string{}
+	err = e.flatten(object, nil, func(key string, location jsonPointer, leaf any) error {
+		name := e.prefix + key
+		if e.uppercase {
+			name = strings.ToUpper(name)
+		}
+		if !envNamePattern.MatchString(name) {
+			return fmt.Errorf("%q at %q is not a valid environment variable name", name, location.String())
+		}
+		if previous, ok := seen[name]; ok {
+			return fmt.Errorf("keys at %q and %q both map to the variable %q", previous, location.String(), name)
+		}
+		seen[name] = location.String()
+
+		if e.export {
+			b.WriteString("export ")
+		}
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(envQuote(keyValueLeafString(leaf)))
+		b.WriteByte('\n')
+		return nil
+	})
+	return b.String(), err
+}
+
+// envQuote quotes a dotenv value. Plain values are written as they are,
+// values without single quotes or line breaks in single quotes, which
+// disable escapes and variable expansion, and other values in double quotes
+// with backslash escapes.
+func envQuote(s string) string {
+	switch {
+	case envPlainValuePattern.MatchString(s):
+		return s
+	case !strings.ContainsAny(s, "'\n\r"):
+		return "'" + s + "'"
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '\\', '"', '$', '`':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// iniFormatter renders a document as an INI file.
+type iniFormatter struct {
+	keyValueFlattener
+}
+
+// format writes the scalar members of the document as global keys and each
+// object member as a section. Objects nested in sections are flattened into
+// their keys.
+func (f iniFormatter) format(document any) (string, error) {
+	object, err := requireJSONObject(document)
+	if err != nil {
+		return "", err
+	}
+
+	var globals strings.Builder
+	globalsSeen := map[string]string{}
+	var sections []string
+	write := func(b *strings.Builder, seen map[string]string) func(key string, location jsonPointer, leaf any) error {
+		return func(key string, location jsonPointer, leaf any) error {
+			if key == "" || strings.TrimSpace(key) != key || strings.HasPrefix(key, "[") || strings.ContainsAny(key, "=;#\r\n\"") {
+				return fmt.Errorf("key %q at %q cannot be written in INI", key, location.String())
+			}
+			if previous, ok := seen[key]; ok {
+				return fmt.Errorf("keys at %q and %q both map to the key %q", previous, location.String(), key)
+			}
+			seen[key] = location.String()
+			value, err := iniQuote(keyValueLeafString(leaf), location)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(b, "%s = %s\n", key, value)
+			return nil
+		}
+	}
+
+	for _, key := range object.Keys() {
+		member, _ := object.Get(key)
+		section, isSection := member.(*jsonObject)
+		if !isSection {
+			single := newJSONObject()
+			single.Set(key, member)
+			if err := f.flatten(single, nil, write(&globals, globalsSeen)); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		location := jsonPointer{key}
+		if key == "" || strings.TrimSpace(key) != key || strings.ContainsAny(key, "[]\r\n") {
+			return "", fmt.Errorf("section name %q at %q cannot be written in INI", key, location.String())
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "[%s]\n", key)
+		if err := f.flatten(section, location, write(&b, map[string]string{})); err != nil {
+			return "", err
+		}
+		sections = append(sections, b.String())
+	}
+
+	if globals.Len() > 0 {
+		sections = append([]string{globals.String()}, sections...)
+	}
+	return strings.Join(sections, "\n"), nil
+}
+
+// iniQuote quotes an INI value in double quotes with backslash escapes when
+// it would otherwise be misread: when it is empty, has surrounding spaces or
+// holds quotes, comment characters, backslashes or line breaks.
+func iniQuote(s string, location jsonPointer) (string, error) {
+	if s != "" && strings.TrimSpace(s) == s && !strings.ContainsAny(s, "\";#\\\r\n") {
+		return s, nil
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '\\', '"':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				return "", fmt.Errorf("string at %q contains the control character U+%04X, which cannot be written in INI", location.String(), r)
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String(), nil
+}
+
+// propertiesFormatter renders a document as a Java properties file.
+type propertiesFormatter struct {
+	keyValueFlattener
+	ascii bool
+}
+
+// format writes one key=value line per leaf.
+func (p propertiesFormatter) format(document any) (string, error) {
+	object, err := requireJSONObject(document)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	seen := map[string]string{}
+	err = p.flatten(object, nil, func(key string, location jsonPointer, leaf any) error {
+		if previous, ok := seen[key]; ok {
+			return fmt.Errorf("keys at %q and %q both map to the key %q", previous, location.String(), key)
+		}
+		seen[key] = location.String()
+		b.WriteString(p.escape(key, true))
+		b.WriteByte('=')
+		b.WriteString(p.escape(keyValueLeafString(leaf), false))
+		b.WriteByte('\n')
+		return nil
+	})
+	return b.String(), err
+}
+
+// escape escapes a key or value as java.util.Properties.store does. Spaces
+// are escaped everywhere in keys and at the start of values. With ascii,
+// characters outside printable ASCII are written as \uXXXX escapes so that
+// the file can be read as ISO 8859-1.
+func (p propertiesFormatter) escape(s string, key bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == ' ' && (key || i == 0):
+			b.WriteString(`\ `)
+		case r == '\\', r == '=', r == ':', r == '#', r == '!':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\f':
+			b.WriteString(`\f`)
+		case r < 0x20 || r == 0x7f || (p.ascii && r > 0x7e):
+			if r > 0xFFFF {
+				high, low := utf16.EncodeRune(r)
+				fmt.Fprintf(&b, `\u%04X\u%04X`, high, low)
+			} else {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
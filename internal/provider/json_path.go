@@ -0,0 +1,1211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// This file implements JSONPath as specified in RFC 9535. Queries are parsed
+// once into a small syntax tree and evaluated against the ordered document
+// model, so results keep the document's member order and number literals.
+
+// jsonPathMaxSafeInteger bounds integers in queries to the I-JSON range.
+const jsonPathMaxSafeInteger = 1<<53 - 1
+
+// jsonPathNode is a value selected by a query together with its location.
+type jsonPathNode struct {
+	location jsonPointer
+	value    any
+}
+
+// jsonPathQuery is a parsed query. Queries within filter expressions that
+// start with '@' are relative to the current node.
+type jsonPathQuery struct {
+	relative bool
+	segments []jsonPathSegment
+}
+
+type jsonPathSegment struct {
+	descendant bool
+	selectors  []jsonPathSelector
+}
+
+type jsonPathSelector interface {
+	apply(e *jsonPathEvaluator, node jsonPathNode, out []jsonPathNode) []jsonPathNode
+}
+
+type jsonPathNameSelector struct{ name string }
+
+type jsonPathWildcardSelector struct{}
+
+type jsonPathIndexSelector struct{ index int }
+
+type jsonPathSliceSelector struct {
+	start, end *int
+	step       int
+}
+
+type jsonPathFilterSelector struct{ expr jsonPathLogicalExpr }
+
+// jsonPathSyntaxError reports an invalid query with the offset of the
+// offending character.
+type jsonPathSyntaxError struct {
+	Position int
+	Reason   string
+}
+
+func (e *jsonPathSyntaxError) Error() string {
+	return fmt.Sprintf("at position %d: %s", e.Position, e.Reason)
+}
+
+// parseJSONPath parses an RFC 9535 JSONPath query.
+func parseJSONPath(expression string) (*jsonPathQuery, error) {
+	p := &jsonPathParser{input: expression}
+	if !p.consume('$') {
+		return nil, p.errorf("query must start with '$'")
+	}
+	query, err := p.parseSegments(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.input) {
+		return nil, p.errorf("unexpected character %q", p.peekRune())
+	}
+	return query, nil
+}
+
+// Select evaluates the query against document and returns the selected nodes
+// in document order.
+func (q *jsonPathQuery) Select(document any) []jsonPathNode {
+	e := &jsonPathEvaluator{root: document, regexps: map[string]*regexp.Regexp{}}
+	return e.evaluate(q, jsonPathNode{location: jsonPointer{}, value: document})
+}
+
+// Values returns the values of the selected nodes.
+func (q *jsonPathQuery) Values(document any) []any {
+	nodes := q.Select(document)
+	values := make([]any, len(nodes))
+	for i, node := range nodes {
+		values[i] = node.value
+	}
+	return values
+}
+
+// singular reports whether the query can select at most one node, which is
+// required for queries used as comparison operands.
+func (q *jsonPathQuery) singular() bool {
+	for _, segment := range q.segments {
+		if segment.descendant || len(segment.selectors) != 1 {
+			return false
+		}
+		switch segment.selectors[0].(type) {
+		case jsonPathNameSelector, jsonPathIndexSelector:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+type jsonPathEvaluator struct {
+	root    any
+	regexps map[string]*regexp.Regexp
+}
+
+func (e *jsonPathEvaluator) evaluate(q *jsonPathQuery, start jsonPathNode) []jsonPathNode {
+	nodes := []jsonPathNode{start}
+	for _, segment := range q.segments {
+		var next []jsonPathNode
+		for _, node := range nodes {
+			if segment.descendant {
+				next = e.applyDescendant(segment.selectors, node, next)
+				continue
+			}
+			for _, selector := range segment.selectors {
+				next = selector.apply(e, node, next)
+			}
+		}
+		nodes = next
+		if len(nodes) == 0 {
+			break
+		}
+	}
+	return nodes
+}
+
+// applyDescendant applies the selectors to node and then to each of its
+// descendants, visiting the tree in document order.
+func (e *jsonPathEvaluator) applyDescendant(selectors []jsonPathSelector, node jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	for _, selector := range selectors {
+		out = selector.apply(e, node, out)
+	}
+	for _, child := range jsonPathChildren(node) {
+		out = e.applyDescendant(selectors, child, out)
+	}
+	return out
+}
+
+// jsonPathChildren returns the direct children of a node in document order.
+func jsonPathChildren(node jsonPathNode) []jsonPathNode {
+	switch v := node.value.(type) {
+	case *jsonObject:
+		children := make([]jsonPathNode, 0, v.Len())
+		for _, key := range v.Keys() {
+			value, _ := v.Get(key)
+			children = append(children, jsonPathNode{location: node.location.child(key), value: value})
+		}
+		return children
+	case []any:
+		children := make([]jsonPathNode, len(v))
+		for i, value := range v {
+			children[i] = jsonPathNode{location: node.location.child(strconv.Itoa(i)), value: value}
+		}
+		return children
+	default:
+		return nil
+	}
+}
+
+// child returns a new pointer extended by token, never sharing the backing
+// array with p.
+func (p jsonPointer) child(token string) jsonPointer {
+	return append(p[:len(p):len(p)], token)
+}
+
+func (s jsonPathNameSelector) apply(_ *jsonPathEvaluator, node jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	if object, ok := node.value.(*jsonObject); ok {
+		if value, exists := object.Get(s.name); exists {
+			out = append(out, jsonPathNode{location: node.location.child(s.name), value: value})
+		}
+	}
+	return out
+}
+
+func (s jsonPathWildcardSelector) apply(_ *jsonPathEvaluator, node jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	return append(out, jsonPathChildren(node)...)
+}
+
+func (s jsonPathIndexSelector) apply(_ *jsonPathEvaluator, node jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	array, ok := node.value.([]any)
+	if !ok {
+		return out
+	}
+	index := s.index
+	if index < 0 {
+		index += len(array)
+	}
+	if index < 0 || index >= len(array) {
+		return out
+	}
+	return append(out, jsonPathNode{location: node.location.child(strconv.Itoa(index)), value: array[index]})
+}
+
+// apply implements the slice semantics of RFC 9535, section 2.3.4.2.2.
+func (s jsonPathSliceSelector) apply(_ *jsonPathEvaluator, node jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	array, ok := node.value.([]any)
+	if !ok || s.step == 0 {
+		return out
+	}
+	length := len(array)
+	normalize := func(i int) int {
+		if i >= 0 {
+			return i
+		}
+		return length + i
+	}
+	clamp := func(i, lower, upper int) int {
+		return min(max(i, lower), upper)
+	}
+
+	emit := func(i int) {
+		out = append(out, jsonPathNode{location: node.location.child(strconv.Itoa(i)), value: array[i]})
+	}
+
+	if s.step > 0 {
+		start, end := 0, length
+		if s.start != nil {
+			start = normalize(*s.start)
+		}
+		if s.end != nil {
+			end = normalize(*s.end)
+		}
+		lower, upper := clamp(start, 0, length), clamp(end, 0, length)
+		for i := lower; i < upper; i += s.step {
+			emit(i)
+		}
+		return out
+	}
+
+	start, end := length-1, -length-1
+	if s.start != nil {
+		start = normalize(*s.start)
+	}
+	if s.end != nil {
+		end = normalize(*s.end)
+	}
+	upper, lower := clamp(start, -1, length-1), clamp(end, -1, length-1)
+	for i := upper; lower < i; i += s.step {
+		emit(i)
+	}
+	return out
+}
+
+func (s jsonPathFilterSelector) apply(e *jsonPathEvaluator, node jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	for _, child := range jsonPathChildren(node) {
+		if s.expr.test(e, child) {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+// Filter expressions.
+
+type jsonPathLogicalExpr interface {
+	test(e *jsonPathEvaluator, current jsonPathNode) bool
+}
+
+type jsonPathOrExpr struct{ operands []jsonPathLogicalExpr }
+
+type jsonPathAndExpr struct{ operands []jsonPathLogicalExpr }
+
+type jsonPathNotExpr struct{ operand jsonPathLogicalExpr }
+
+type jsonPathExistenceExpr struct{ query *jsonPathQuery }
+
+type jsonPathComparisonExpr struct {
+	left, right jsonPathComparable
+	op          string
+}
+
+func (x jsonPathOrExpr) test(e *jsonPathEvaluator, current jsonPathNode) bool {
+	for _, operand := range x.operands {
+		if operand.test(e, current) {
+			return true
+		}
+	}
+	return false
+}
+
+func (x jsonPathAndExpr) test(e *jsonPathEvaluator, current jsonPathNode) bool {
+	for _, operand := range x.operands {
+		if !operand.test(e, current) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x jsonPathNotExpr) test(e *jsonPathEvaluator, current jsonPathNode) bool {
+	return !x.operand.test(e, current)
+}
+
+func (x jsonPathExistenceExpr) test(e *jsonPathEvaluator, current jsonPathNode) bool {
+	return len(e.evaluateFilterQuery(x.query, current)) > 0
+}
+
+func (e *jsonPathEvaluator) evaluateFilterQuery(q *jsonPathQuery, current jsonPathNode) []jsonPathNode {
+	if q.relative {
+		return e.evaluate(q, current)
+	}
+	return e.evaluate(q, jsonPathNode{location: jsonPointer{}, value: e.root})
+}
+
+// test implements the comparison semantics of RFC 9535, section 2.3.5.2.2.
+// An operand that selects nothing only equals another operand that selects
+// nothing, and ordering is defined for numbers and strings only.
+func (x jsonPathComparisonExpr) test(e *jsonPathEvaluator, current jsonPathNode) bool {
+	left, leftOK := x.left.evaluate(e, current)
+	right, rightOK := x.right.evaluate(e, current)
+
+	equal := func() bool {
+		if !leftOK || !rightOK {
+			return leftOK == rightOK
+		}
+		return jsonValuesEqual(left, right)
+	}
+	less := func(a, b any, aOK, bOK bool) bool {
+		if !aOK || !bOK {
+			return false
+		}
+		switch av := a.(type) {
+		case json.Number:
+			bv, ok := b.(json.Number)
+			return ok && compareJSONNumbers(av, bv) < 0
+		case string:
+			bv, ok := b.(string)
+			return ok && av < bv
+		}
+		return false
+	}
+
+	switch x.op {
+	case "==":
+		return equal()
+	case "!=":
+		return !equal()
+	case "<":
+		return less(left, right, leftOK, rightOK)
+	case ">":
+		return less(right, left, rightOK, leftOK)
+	case "<=":
+		return less(left, right, leftOK, rightOK) || equal()
+	case ">=":
+		return less(right, left, rightOK, leftOK) || equal()
+	}
+	return false
+}
+
+// compareJSONNumbers compares two number literals numerically.
+func compareJSONNumbers(a, b json.Number) int {
+	ar, aok := new(big.Rat).SetString(a.String())
+	br, bok := new(big.Rat).SetString(b.String())
+	if !aok || !bok {
+		return strings.Compare(a.String(), b.String())
+	}
+	return ar.Cmp(br)
+}
+
+// jsonPathComparable is an operand of a comparison. evaluate reports false
+// when the operand selects nothing.
+type jsonPathComparable interface {
+	evaluate(e *jsonPathEvaluator, current jsonPathNode) (any, bool)
+}
+
+type jsonPathLiteral struct{ value any }
+
+type jsonPathSingularQuery struct{ query *jsonPathQuery }
+
+func (l jsonPathLiteral) evaluate(*jsonPathEvaluator, jsonPathNode) (any, bool) {
+	return l.value, true
+}
+
+func (s jsonPathSingularQuery) evaluate(e *jsonPathEvaluator, current jsonPathNode) (any, bool) {
+	nodes := e.evaluateFilterQuery(s.query, current)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0].value, true
+}
+
+// Function extensions.
+
+type jsonPathType int
+
+const (
+	jsonPathValueType jsonPathType = iota
+	jsonPathLogicalType
+	jsonPathNodesType
+)
+
+type jsonPathFunctionSignature struct {
+	parameters []jsonPathType
+	result     jsonPathType
+}
+
+// jsonPathFunctions lists the function extensions defined by RFC 9535,
+// section 2.4.
+var jsonPathFunctions = map[string]jsonPathFunctionSignature{
+	"length": {parameters: []jsonPathType{jsonPathValueType}, result: jsonPathValueType},
+	"count":  {parameters: []jsonPathType{jsonPathNodesType}, result: jsonPathValueType},
+	"match":  {parameters: []jsonPathType{jsonPathValueType, jsonPathValueType}, result: jsonPathLogicalType},
+	"search": {parameters: []jsonPathType{jsonPathValueType, jsonPathValueType}, result: jsonPathLogicalType},
+	"value":  {parameters: []jsonPathType{jsonPathNodesType}, result: jsonPathValueType},
+}
+
+// jsonPathFunctionExpr is a function call. Each argument is either a
+// jsonPathComparable (value arguments) or a *jsonPathQuery (nodes arguments).
+type jsonPathFunctionExpr struct {
+	name string
+	args []any
+}
+
+func (f jsonPathFunctionExpr) valueArgument(e *jsonPathEvaluator, current jsonPathNode, i int) (any, bool) {
+	comparable, ok := f.args[i].(jsonPathComparable)
+	if !ok {
+		return nil, false
+	}
+	return comparable.evaluate(e, current)
+}
+
+func (f jsonPathFunctionExpr) nodesArgument(e *jsonPathEvaluator, current jsonPathNode, i int) []jsonPathNode {
+	query, ok := f.args[i].(*jsonPathQuery)
+	if !ok {
+		return nil
+	}
+	return e.evaluateFilterQuery(query, current)
+}
+
+// evaluate implements the value-typed functions.
+func (f jsonPathFunctionExpr) evaluate(e *jsonPathEvaluator, current jsonPathNode) (any, bool) {
+	switch f.name {
+	case "length":
+		value, ok := f.valueArgument(e, current, 0)
+		if !ok {
+			return nil, false
+		}
+		switch v := value.(type) {
+		case string:
+			return json.Number(strconv.Itoa(utf8.RuneCountInString(v))), true
+		case []any:
+			return json.Number(strconv.Itoa(len(v))), true
+		case *jsonObject:
+			return json.Number(strconv.Itoa(v.Len())), true
+		}
+		return nil, false
+	case "count":
+		return json.Number(strconv.Itoa(len(f.nodesArgument(e, current, 0)))), true
+	case "value":
+		nodes := f.nodesArgument(e, current, 0)
+		if len(nodes) != 1 {
+			return nil, false
+		}
+		return nodes[0].value, true
+	}
+	return nil, false
+}
+
+// test implements the logical-typed functions match() and search().
+func (f jsonPathFunctionExpr) test(e *jsonPathEvaluator, current jsonPathNode) bool {
+	value, ok := f.valueArgument(e, current, 0)
+	if !ok {
+		return false
+	}
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	pattern, ok := f.valueArgument(e, current, 1)
+	if !ok {
+		return false
+	}
+	patternString, ok := pattern.(string)
+	if !ok {
+		return false
+	}
+	re := e.compileIRegexp(patternString, f.name == "match")
+	return re != nil && re.MatchString(s)
+}
+
+// compileIRegexp compiles an RFC 9485 I-Regexp. match() requires the whole
+// string to match, so the pattern is anchored. Invalid patterns yield nil,
+// which makes the function result false.
+func (e *jsonPathEvaluator) compileIRegexp(pattern string, anchored bool) *regexp.Regexp {
+	key := strconv.FormatBool(anchored) + ":" + pattern
+	if re, ok := e.regexps[key]; ok {
+		return re
+	}
+
+	// I-Regexp's '.' matches any character except line breaks, while RE2's
+	// excludes only '\n'.
+	var b strings.Builder
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			b.WriteByte(c)
+			i++
+			b.WriteByte(pattern[i])
+		case c == '[':
+			inClass = true
+			b.WriteByte(c)
+		case c == ']':
+			inClass = false
+			b.WriteByte(c)
+		case c == '.' && !inClass:
+			b.WriteString(`[^\n\r]`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	translated := b.String()
+	if anchored {
+		translated = `^(?:` + translated + `)$`
+	}
+
+	re, err := regexp.Compile(translated)
+	if err != nil {
+		re = nil
+	}
+	e.regexps[key] = re
+	return re
+}
+
+// Parser.
+
+type jsonPathParser struct {
+	input string
+	pos   int
+}
+
+func (p *jsonPathParser) errorf(format string, args ...any) error {
+	return &jsonPathSyntaxError{Position: p.pos, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (p *jsonPathParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *jsonPathParser) peekRune() rune {
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+	return r
+}
+
+func (p *jsonPathParser) consume(c byte) bool {
+	if p.peek() == c && p.pos < len(p.input) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *jsonPathParser) consumeString(s string) bool {
+	if strings.HasPrefix(p.input[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *jsonPathParser) skipBlanks() {
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *jsonPathParser) expect(c byte) error {
+	if !p.consume(c) {
+		if p.pos >= len(p.input) {
+			return p.errorf("expected %q, got end of expression", c)
+		}
+		return p.errorf("expected %q, got %q", c, p.peekRune())
+	}
+	return nil
+}
+
+// parseSegments parses the segments following a root or current node
+// identifier.
+func (p *jsonPathParser) parseSegments(relative bool) (*jsonPathQuery, error) {
+	query := &jsonPathQuery{relative: relative}
+	for {
+		start := p.pos
+		p.skipBlanks()
+		switch {
+		case strings.HasPrefix(p.input[p.pos:], ".."):
+			p.pos += 2
+			selectors, err := p.parseSegmentBody(true)
+			if err != nil {
+				return nil, err
+			}
+			query.segments = append(query.segments, jsonPathSegment{descendant: true, selectors: selectors})
+		case p.peek() == '.' || p.peek() == '[':
+			selectors, err := p.parseSegmentBody(false)
+			if err != nil {
+				return nil, err
+			}
+			query.segments = append(query.segments, jsonPathSegment{selectors: selectors})
+		default:
+			p.pos = start
+			return query, nil
+		}
+	}
+}
+
+// parseSegmentBody parses a bracketed selection or a shorthand. For child
+// segments the leading '.' of a shorthand has not been consumed yet.
+func (p *jsonPathParser) parseSegmentBody(descendant bool) ([]jsonPathSelector, error) {
+	if p.peek() == '[' {
+		return p.parseBracketedSelection()
+	}
+	if !descendant && !p.consume('.') {
+		return nil, p.errorf("expected '.' or '['")
+	}
+	if p.consume('*') {
+		return []jsonPathSelector{jsonPathWildcardSelector{}}, nil
+	}
+	name, ok := p.parseMemberNameShorthand()
+	if !ok {
+		if p.pos >= len(p.input) {
+			return nil, p.errorf("expected member name, '*' or '[' after '.', got end of expression")
+		}
+		return nil, p.errorf("expected member name, '*' or '[' after '.', got %q", p.peekRune())
+	}
+	return []jsonPathSelector{jsonPathNameSelector{name: name}}, nil
+}
+
+func isJSONPathNameFirst(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r >= 0x80
+}
+
+func isJSONPathNameChar(r rune) bool {
+	return isJSONPathNameFirst(r) || (r >= '0' && r <= '9')
+}
+
+func (p *jsonPathParser) parseMemberNameShorthand() (string, bool) {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if (p.pos == start && !isJSONPathNameFirst(r)) || !isJSONPathNameChar(r) {
+			break
+		}
+		p.pos += size
+	}
+	return p.input[start:p.pos], p.pos > start
+}
+
+func (p *jsonPathParser) parseBracketedSelection() ([]jsonPathSelector, error) {
+	if err := p.expect('['); err != nil {
+		return nil, err
+	}
+	var selectors []jsonPathSelector
+	for {
+		p.skipBlanks()
+		selector, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+		p.skipBlanks()
+		if p.consume(',') {
+			continue
+		}
+		if err := p.expect(']'); err != nil {
+			return nil, err
+		}
+		return selectors, nil
+	}
+}
+
+func (p *jsonPathParser) parseSelector() (jsonPathSelector, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		name, err := p.parseStringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathNameSelector{name: name}, nil
+	case c == '*':
+		p.pos++
+		return jsonPathWildcardSelector{}, nil
+	case c == '?':
+		p.pos++
+		p.skipBlanks()
+		expr, err := p.parseLogicalOr()
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathFilterSelector{expr: expr}, nil
+	case c == ':' || c == '-' || (c >= '0' && c <= '9'):
+		return p.parseIndexOrSlice()
+	case p.pos >= len(p.input):
+		return nil, p.errorf("expected selector, got end of expression")
+	default:
+		return nil, p.errorf("expected selector, got %q", p.peekRune())
+	}
+}
+
+func (p *jsonPathParser) parseIndexOrSlice() (jsonPathSelector, error) {
+	var start *int
+	if p.peek() != ':' {
+		index, err := p.parseInteger()
+		if err != nil {
+			return nil, err
+		}
+		p.skipBlanksBefore(':')
+		if p.peek() != ':' {
+			return jsonPathIndexSelector{index: index}, nil
+		}
+		start = &index
+	}
+
+	slice := jsonPathSliceSelector{start: start, step: 1}
+	p.pos++ // ':'
+	p.skipBlanks()
+	if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+		end, err := p.parseInteger()
+		if err != nil {
+			return nil, err
+		}
+		slice.end = &end
+		p.skipBlanks()
+	}
+	if p.consume(':') {
+		p.skipBlanks()
+		if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+			step, err := p.parseInteger()
+			if err != nil {
+				return nil, err
+			}
+			slice.step = step
+		}
+	}
+	return slice, nil
+}
+
+// skipBlanksBefore skips blanks only when they are followed by c.
+func (p *jsonPathParser) skipBlanksBefore(c byte) {
+	start := p.pos
+	p.skipBlanks()
+	if p.peek() != c {
+		p.pos = start
+	}
+}
+
+// parseInteger parses an RFC 9535 int: no leading zeros, no "-0", within the
+// I-JSON safe integer range.
+func (p *jsonPathParser) parseInteger() (int, error) {
+	start := p.pos
+	p.consume('-')
+	digitsStart := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	text := p.input[start:p.pos]
+	digits := p.input[digitsStart:p.pos]
+	if digits == "" {
+		p.pos = start
+		return 0, p.errorf("expected integer")
+	}
+	if (len(digits) > 1 && digits[0] == '0') || text == "-0" {
+		p.pos = start
+		return 0, p.errorf("invalid integer %q", text)
+	}
+	value, err := strconv.ParseInt(text, 10, 64)
+	if err != nil || value > jsonPathMaxSafeInteger || value < -jsonPathMaxSafeInteger {
+		p.pos = start
+		return 0, p.errorf("integer %s is out of range", text)
+	}
+	return int(value), nil
+}
+
+// parseStringLiteral parses a single- or double-quoted string literal.
+func (p *jsonPathParser) parseStringLiteral() (string, error) {
+	quote := p.input[p.pos]
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.input) {
+			return "", p.errorf("unterminated string literal")
+		}
+		c := p.input[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c < 0x20:
+			return "", p.errorf("control character in string literal")
+		case c == '\\':
+			p.pos++
+			if p.pos >= len(p.input) {
+				return "", p.errorf("unterminated string literal")
+			}
+			escaped := p.input[p.pos]
+			p.pos++
+			switch escaped {
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '/', '\\':
+				b.WriteByte(escaped)
+			case 'u':
+				r, err := p.parseUnicodeEscape()
+				if err != nil {
+					return "", err
+				}
+				b.WriteRune(r)
+			default:
+				if escaped != quote {
+					p.pos -= 2
+					return "", p.errorf("invalid escape sequence \\%c", escaped)
+				}
+				b.WriteByte(escaped)
+			}
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// parseUnicodeEscape parses the hex digits of a \u escape, combining
+// surrogate pairs.
+func (p *jsonPathParser) parseUnicodeEscape() (rune, error) {
+	readHex := func() (rune, error) {
+		if p.pos+4 > len(p.input) {
+			return 0, p.errorf("invalid unicode escape")
+		}
+		value, err := strconv.ParseUint(p.input[p.pos:p.pos+4], 16, 32)
+		if err != nil {
+			return 0, p.errorf("invalid unicode escape")
+		}
+		p.pos += 4
+		return rune(value), nil
+	}
+
+	r, err := readHex()
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case r >= 0xDC00 && r <= 0xDFFF:
+		return 0, p.errorf("unpaired low surrogate in unicode escape")
+	case r >= 0xD800 && r <= 0xDBFF:
+		if !p.consumeString(`\u`) {
+			return 0, p.errorf("unpaired high surrogate in unicode escape")
+		}
+		low, err := readHex()
+		if err != nil {
+			return 0, err
+		}
+		if low < 0xDC00 || low > 0xDFFF {
+			return 0, p.errorf("invalid low surrogate in unicode escape")
+		}
+		return utf16.DecodeRune(r, low), nil
+	}
+	return r, nil
+}
+
+func (p *jsonPathParser) parseLogicalOr() (jsonPathLogicalExpr, error) {
+	var operands []jsonPathLogicalExpr
+	for {
+		operand, err := p.parseLogicalAnd()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+		p.skipBlanks()
+		if !p.consumeString("||") {
+			break
+		}
+		p.skipBlanks()
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return jsonPathOrExpr{operands: operands}, nil
+}
+
+func (p *jsonPathParser) parseLogicalAnd() (jsonPathLogicalExpr, error) {
+	var operands []jsonPathLogicalExpr
+	for {
+		operand, err := p.parseBasicExpr()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+		p.skipBlanks()
+		if !p.consumeString("&&") {
+			break
+		}
+		p.skipBlanks()
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return jsonPathAndExpr{operands: operands}, nil
+}
+
+func (p *jsonPathParser) parseBasicExpr() (jsonPathLogicalExpr, error) {
+	if p.peek() == '!' && !strings.HasPrefix(p.input[p.pos:], "!=") {
+		p.pos++
+		p.skipBlanks()
+		operand, err := p.parseNegatableExpr()
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathNotExpr{operand: operand}, nil
+	}
+	if p.peek() == '(' {
+		return p.parseParenExpr()
+	}
+
+	start := p.pos
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipBlanksBeforeComparison()
+	op := p.parseComparisonOperator()
+	if op == "" {
+		return p.testExpr(left, start)
+	}
+
+	leftComparable, err := p.comparable(left, start)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlanks()
+	rightStart := p.pos
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	rightComparable, err := p.comparable(right, rightStart)
+	if err != nil {
+		return nil, err
+	}
+	return jsonPathComparisonExpr{left: leftComparable, right: rightComparable, op: op}, nil
+}
+
+// parseNegatableExpr parses the operand of '!': a parenthesized expression
+// or a test expression.
+func (p *jsonPathParser) parseNegatableExpr() (jsonPathLogicalExpr, error) {
+	if p.peek() == '(' {
+		return p.parseParenExpr()
+	}
+	start := p.pos
+	operand, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return p.testExpr(operand, start)
+}
+
+func (p *jsonPathParser) parseParenExpr() (jsonPathLogicalExpr, error) {
+	p.pos++ // '('
+	p.skipBlanks()
+	expr, err := p.parseLogicalOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlanks()
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+func (p *jsonPathParser) skipBlanksBeforeComparison() {
+	start := p.pos
+	p.skipBlanks()
+	switch p.peek() {
+	case '=', '!', '<', '>':
+	default:
+		p.pos = start
+	}
+}
+
+func (p *jsonPathParser) parseComparisonOperator() string {
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consumeString(op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// parseOperand parses a filter query, function call or literal.
+func (p *jsonPathParser) parseOperand() (any, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		return p.parseSegments(c == '@')
+	case c == '\'' || c == '"':
+		s, err := p.parseStringLiteral()
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathLiteral{value: s}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumberLiteral()
+	case c >= 'a' && c <= 'z':
+		start := p.pos
+		for p.pos < len(p.input) && isJSONPathFunctionNameChar(p.input[p.pos]) {
+			p.pos++
+		}
+		name := p.input[start:p.pos]
+		if p.peek() == '(' {
+			p.pos = start
+			return p.parseFunctionExpr()
+		}
+		switch name {
+		case "true":
+			return jsonPathLiteral{value: true}, nil
+		case "false":
+			return jsonPathLiteral{value: false}, nil
+		case "null":
+			return jsonPathLiteral{value: nil}, nil
+		}
+		p.pos = start
+		return nil, p.errorf("unexpected identifier %q", name)
+	case p.pos >= len(p.input):
+		return nil, p.errorf("expected filter expression, got end of expression")
+	default:
+		return nil, p.errorf("unexpected character %q in filter expression", p.peekRune())
+	}
+}
+
+func isJSONPathFunctionNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
+
+// parseNumberLiteral parses a JSON number literal within a filter.
+func (p *jsonPathParser) parseNumberLiteral() (any, error) {
+	start := p.pos
+	p.consume('-')
+	digitsStart := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	digits := p.input[digitsStart:p.pos]
+	if digits == "" || (len(digits) > 1 && digits[0] == '0') {
+		p.pos = start
+		return nil, p.errorf("invalid number literal")
+	}
+	if p.consume('.') {
+		fractionStart := p.pos
+		for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+			p.pos++
+		}
+		if p.pos == fractionStart {
+			return nil, p.errorf("invalid number literal, expected digits after '.'")
+		}
+	}
+	if p.consume('e') || p.consume('E') {
+		if !p.consume('+') {
+			p.consume('-')
+		}
+		exponentStart := p.pos
+		for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+			p.pos++
+		}
+		if p.pos == exponentStart {
+			return nil, p.errorf("invalid number literal, expected exponent digits")
+		}
+	}
+	return jsonPathLiteral{value: json.Number(p.input[start:p.pos])}, nil
+}
+
+func (p *jsonPathParser) parseFunctionExpr() (any, error) {
+	start := p.pos
+	for p.pos < len(p.input) && isJSONPathFunctionNameChar(p.input[p.pos]) {
+		p.pos++
+	}
+	name := p.input[start:p.pos]
+	signature, ok := jsonPathFunctions[name]
+	if !ok {
+		p.pos = start
+		return nil, p.errorf("unknown function %s()", name)
+	}
+	p.pos++ // '('
+
+	fn := jsonPathFunctionExpr{name: name}
+	p.skipBlanks()
+	if p.peek() != ')' {
+		for {
+			argStart := p.pos
+			arg, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			if len(fn.args) == len(signature.parameters) {
+				p.pos = argStart
+				return nil, p.errorf("function %s() takes %d argument(s)", name, len(signature.parameters))
+			}
+			arg, err = p.functionArgument(name, signature.parameters[len(fn.args)], arg, argStart)
+			if err != nil {
+				return nil, err
+			}
+			fn.args = append(fn.args, arg)
+			p.skipBlanks()
+			if !p.consume(',') {
+				break
+			}
+			p.skipBlanks()
+		}
+	}
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+	if len(fn.args) != len(signature.parameters) {
+		return nil, &jsonPathSyntaxError{Position: start, Reason: fmt.Sprintf(
+			"function %s() takes %d argument(s), got %d", name, len(signature.parameters), len(fn.args))}
+	}
+	return fn, nil
+}
+
+// functionArgument enforces the well-typedness rules of RFC 9535, section
+// 2.4.3, and converts singular queries passed as values into operands.
+func (p *jsonPathParser) functionArgument(name string, parameter jsonPathType, arg any, position int) (any, error) {
+	fail := func(reason string) error {
+		return &jsonPathSyntaxError{Position: position, Reason: fmt.Sprintf("function %s(): %s", name, reason)}
+	}
+	if parameter == jsonPathNodesType {
+		if _, ok := arg.(*jsonPathQuery); !ok {
+			return nil, fail("argument must be a query")
+		}
+		return arg, nil
+	}
+
+	switch a := arg.(type) {
+	case *jsonPathQuery:
+		if !a.singular() {
+			return nil, fail("argument must be a value, not a non-singular query")
+		}
+		return jsonPathSingularQuery{query: a}, nil
+	case jsonPathFunctionExpr:
+		if jsonPathFunctions[a.name].result != jsonPathValueType {
+			return nil, fail(fmt.Sprintf("argument %s() does not return a value", a.name))
+		}
+	}
+	return arg, nil
+}
+
+// comparable converts a parsed operand into a comparison operand.
+func (p *jsonPathParser) comparable(operand any, position int) (jsonPathComparable, error) {
+	switch o := operand.(type) {
+	case jsonPathLiteral:
+		return o, nil
+	case *jsonPathQuery:
+		if !o.singular() {
+			return nil, &jsonPathSyntaxError{Position: position, Reason: "only singular queries can be compared"}
+		}
+		return jsonPathSingularQuery{query: o}, nil
+	case jsonPathFunctionExpr:
+		if jsonPathFunctions[o.name].result != jsonPathValueType {
+			return nil, &jsonPathSyntaxError{Position: position, Reason: fmt.Sprintf("result of %s() cannot be compared", o.name)}
+		}
+		return o, nil
+	}
+	return nil, &jsonPathSyntaxError{Position: position, Reason: "invalid comparison operand"}
+}
+
+// testExpr converts a parsed operand into a test expression.
+func (p *jsonPathParser) testExpr(operand any, position int) (jsonPathLogicalExpr, error) {
+	switch o := operand.(type) {
+	case *jsonPathQuery:
+		return jsonPathExistenceExpr{query: o}, nil
+	case jsonPathFunctionExpr:
+		if jsonPathFunctions[o.name].result == jsonPathValueType {
+			return nil, &jsonPathSyntaxError{Position: position, Reason: fmt.Sprintf("result of %s() must be compared", o.name)}
+		}
+		return o, nil
+	}
+	return nil, &jsonPathSyntaxError{Position: position, Reason: "literal must be compared"}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPointer is a parsed RFC 6901 JSON Pointer. Each element is an unescaped
+// reference token; the empty pointer refers to the whole document.
+type jsonPointer []string
+
+// parseJSONPointer parses the string form of a JSON Pointer.
+func parseJSONPointer(pointer string) (jsonPointer, error) {
+	if pointer == "" {
+		return jsonPointer{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON Pointer %q must be empty or start with '/'", pointer)
+	}
+
+	rawTokens := strings.Split(pointer[1:], "/")
+	tokens := make(jsonPointer, len(rawTokens))
+	for i, raw := range rawTokens {
+		token, err := unescapePointerToken(raw)
+		if err != nil {
+			return nil, fmt.Errorf("JSON Pointer %q: %w", pointer, err)
+		}
+		tokens[i] = token
+	}
+	return tokens, nil
+}
+
+// parseMemberPointer parses a reference to a member of an object element: a
+// JSON Pointer when it starts with '/', otherwise a single member name.
+func parseMemberPointer(key string) (jsonPointer, error) {
+	if strings.HasPrefix(key, "/") {
+		return parseJSONPointer(key)
+	}
+	return jsonPointer{key}, nil
+}
+
+func unescapePointerToken(raw string) (string, error) {
+	if !strings.Contains(raw, "~") {
+		return raw, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '~' {
+			b.WriteByte(raw[i])
+			continue
+		}
+		if i+1 >= len(raw) || (raw[i+1] != '0' && raw[i+1] != '1') {
+			return "", fmt.Errorf("invalid escape sequence in token %q, '~' must be followed by '0' or '1'", raw)
+		}
+		if raw[i+1] == '0' {
+			b.WriteByte('~')
+		} else {
+			b.WriteByte('/')
+		}
+		i++
+	}
+	return b.String(), nil
+}
+
+// escapePointerToken escapes a single reference token for use in a pointer.
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// String returns the escaped string form of the pointer.
+func (p jsonPointer) String() string {
+	var b strings.Builder
+	for _, token := range p {
+		b.WriteByte('/')
+		b.WriteString(escapePointerToken(token))
+	}
+	return b.String()
+}
+
+// Parent returns the pointer to the container of the referenced value and
+// the final reference token. It must not be called on the empty pointer.
+func (p jsonPointer) Parent() (jsonPointer, string) {
+	return p[:len(p)-1], p[len(p)-1]
+}
+
+// IsPrefixOf reports whether p is a proper prefix of other.
+func (p jsonPointer) IsPrefixOf(other jsonPointer) bool {
+	if len(p) >= len(other) {
+		return false
+	}
+	for i := range p {
+		if p[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonPointerError describes a pointer that could not be resolved against a
+// document. Resolved is the longest prefix of Pointer that did resolve.
+type jsonPointerError struct {
+	Pointer  jsonPointer
+	Resolved jsonPointer
+	Reason   string
+}
+
+func (e *jsonPointerError) Error() string {
+	return fmt.Sprintf("path %q could not be resolved: %s (deepest resolved prefix: %q)",
+		e.Pointer.String(), e.Reason, e.Resolved.String())
+}
+
+// Resolve returns the value the pointer refers to within document.
+func (p jsonPointer) Resolve(document any) (any, error) {
+	current := document
+	for i, token := range p {
+		next, reason := childValue(current, token)
+		if reason != "" {
+			return nil, &jsonPointerError{Pointer: p, Resolved: p[:i], Reason: reason}
+		}
+		current = next
+	}
+	return current, nil
+}
+
+// childValue looks up a single reference token within a container. A
+// non-empty reason is returned when the token does not resolve.
+func childValue(container any, token string) (any, string) {
+	switch c := container.(type) {
+	case *jsonObject:
+		value, ok := c.Get(token)
+		if !ok {
+			return nil, fmt.Sprintf("member %q does not exist", token)
+		}
+		return value, ""
+	case []any:
+		index, err := parseArrayIndex(token, len(c), false)
+		if err != nil {
+			return nil, err.Error()
+		}
+		return c[index], ""
+	default:
+		return nil, fmt.Sprintf("cannot descend into %s with token %q", jsonTypeName(container), token)
+	}
+}
+
+// parseArrayIndex validates an array reference token against an array of the
+// given length. When allowEnd is set, "-" and an index equal to the length
+// address the position just past the last element.
+func parseArrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" {
+		if allowEnd {
+			return length, nil
+		}
+		return 0, fmt.Errorf("array index '-' refers to a nonexistent element")
+	}
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid array index %q", token)
+		}
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	limit := length - 1
+	if allowEnd {
+		limit = length
+	}
+	if index > limit {
+		return 0, fmt.Errorf("array index %d is out of bounds for array of length %d", index, length)
+	}
+	return index, nil
+}
+
+// pointerAdd inserts value at the location referenced by p, following the
+// RFC 6902 "add" semantics: object members are created or replaced, array
+// elements are inserted and "-" appends. The (possibly new) document root is
+// returned.
+func pointerAdd(document any, p jsonPointer, value any) (any, error) {
+	if len(p) == 0 {
+		return value, nil
+	}
+	parentPointer, token := p.Parent()
+	return updateContainer(document, p, parentPointer, func(container any) (any, error) {
+		switch c := container.(type) {
+		case *jsonObject:
+			c.Set(token, value)
+			return c, nil
+		case []any:
+			index, err := parseArrayIndex(token, len(c), true)
+			if err != nil {
+				return nil, &jsonPointerError{Pointer: p, Resolved: parentPointer, Reason: err.Error()}
+			}
+			c = append(c, nil)
+			copy(c[index+1:], c[index:])
+			c[index] = value
+			return c, nil
+		default:
+			return nil, &jsonPointerError{
+				Pointer:  p,
+				Resolved: parentPointer,
+				Reason:   fmt.Sprintf("cannot add a member to %s", jsonTypeName(container)),
+			}
+		}
+	})
+}
+
+// pointerRemove deletes the value referenced by p and returns the new
+// document root together with the removed value.
+func pointerRemove(document any, p jsonPointer) (any, any, error) {
+	if len(p) == 0 {
+		return nil, document, nil
+	}
+	var removed any
+	parentPointer, token := p.Parent()
+	root, err := updateContainer(document, p, parentPointer, func(container any) (any, error) {
+		switch c := container.(type) {
+		case *jsonObject:
+			value, ok := c.Get(token)
+			if !ok {
+				return nil, &jsonPointerError{Pointer: p, Resolved: parentPointer, Reason: fmt.Sprintf("member %q does not exist", token)}
+			}
+			removed = value
+			c.Delete(token)
+			return c, nil
+		case []any:
+			index, err := parseArrayIndex(token, len(c), false)
+			if err != nil {
+				return nil, &jsonPointerError{Pointer: p, Resolved: parentPointer, Reason: err.Error()}
+			}
+			removed = c[index]
+			return append(c[:index], c[index+1:]...), nil
+		default:
+			return nil, &jsonPointerError{
+				Pointer:  p,
+				Resolved: parentPointer,
+				Reason:   fmt.Sprintf("cannot remove a member from %s", jsonTypeName(container)),
+			}
+		}
+	})
+	return root, removed, err
+}
+
+// pointerReplace replaces the existing value referenced by p.
+func pointerReplace(document any, p jsonPointer, value any) (any, error) {
+	if len(p) == 0 {
+		return value, nil
+	}
+	if _, err := p.Resolve(document); err != nil {
+		return nil, err
+	}
+	parentPointer, token := p.Parent()
+	return updateContainer(document, p, parentPointer, func(container any) (any, error) {
+		switch c := container.(type) {
+		case *jsonObject:
+			c.Set(token, value)
+			return c, nil
+		case []any:
+			index, err := parseArrayIndex(token, len(c), false)
+			if err != nil {
+				return nil, &jsonPointerError{Pointer: p, Resolved: parentPointer, Reason: err.Error()}
+			}
+			c[index] = value
+			return c, nil
+		default:
+			return nil, &jsonPointerError{
+				Pointer:  p,
+				Resolved: parentPointer,
+				Reason:   fmt.Sprintf("cannot replace a member of %s", jsonTypeName(container)),
+			}
+		}
+	})
+}
+
+// pointerSet stores value at the location referenced by p, creating or
+// replacing object members and replacing array elements; "-" appends to an
+// array. With createParents, missing intermediate containers are created as
+// objects, or as arrays when the following token is "-".
+func pointerSet(document any, p jsonPointer, value any, createParents bool) (any, error) {
+	return setPointerValue(document, p, 0, value, createParents)
+}
+
+func setPointerValue(current any, p jsonPointer, depth int, value any, createParents bool) (any, error) {
+	if depth == len(p) {
+		return value, nil
+	}
+	token := p[depth]
+	last := depth == len(p)-1
+
+	switch c := current.(type) {
+	case *jsonObject:
+		child, exists := c.Get(token)
+		if !exists && !last {
+			if !createParents {
+				return nil, &jsonPointerError{Pointer: p, Resolved: p[:depth], Reason: fmt.Sprintf("member %q does not exist", token)}
+			}
+			child = newParentContainer(p[depth+1])
+		}
+		updated, err := setPointerValue(child, p, depth+1, value, createParents)
+		if err != nil {
+			return nil, err
+		}
+		c.Set(token, updated)
+		return c, nil
+	case []any:
+		if token == "-" {
+			if !last && !createParents {
+				return nil, &jsonPointerError{Pointer: p, Resolved: p[:depth], Reason: "array index '-' refers to a nonexistent element"}
+			}
+			var child any
+			if !last {
+				child = newParentContainer(p[depth+1])
+			}
+			updated, err := setPointerValue(child, p, depth+1, value, createParents)
+			if err != nil {
+				return nil, err
+			}
+			return append(c, updated), nil
+		}
+		index, err := parseArrayIndex(token, len(c), false)
+		if err != nil {
+			return nil, &jsonPointerError{Pointer: p, Resolved: p[:depth], Reason: err.Error()}
+		}
+		updated, err := setPointerValue(c[index], p, depth+1, value, createParents)
+		if err != nil {
+			return nil, err
+		}
+		c[index] = updated
+		return c, nil
+	default:
+		return nil, &jsonPointerError{
+			Pointer:  p,
+			Resolved: p[:depth],
+			Reason:   fmt.Sprintf("cannot descend into %s with token %q", jsonTypeName(current), token),
+		}
+	}
+}
+
+// newParentContainer returns the empty container created for a missing
+// parent whose child is addressed by token.
+func newParentContainer(token string) any {
+	if token == "-" {
+		return []any{}
+	}
+	return newJSONObject()
+}
+
+// updateContainer resolves the container at parentPointer, applies update to
+// it and stores the result back into the document. Arrays are passed by
+// value, so each level re-assigns the updated child into its own parent.
+func updateContainer(document any, target, parentPointer jsonPointer, update func(container any) (any, error)) (any, error) {
+	if len(parentPointer) == 0 {
+		return update(document)
+	}
+
+	grandparentPointer, token := parentPointer.Parent()
+	return updateContainer(document, target, grandparentPointer, func(container any) (any, error) {
+		child, reason := childValue(container, token)
+		if reason != "" {
+			return nil, &jsonPointerError{Pointer: target, Resolved: grandparentPointer, Reason: reason}
+		}
+		updated, err := update(child)
+		if err != nil {
+			return nil, err
+		}
+		switch c := container.(type) {
+		case *jsonObject:
+			c.Set(token, updated)
+		case []any:
+			index, err := parseArrayIndex(token, len(c), false)
+			if err != nil {
+				return nil, &jsonPointerError{Pointer: target, Resolved: grandparentPointer, Reason: err.Error()}
+			}
+			c[index] = updated
+		}
+		return container, nil
+	})
+}
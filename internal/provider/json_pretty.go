@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// isPrettyJSON reports whether data is exactly what json.MarshalIndent
+// produces with indent for the value that json.Unmarshal decodes from it, so
+// that jsonprettyprint can return it without decoding and encoding it again.
+// This requires object keys in sorted order without duplicates, numbers in
+// the shortest form of their float64 value and strings escaped as the
+// encoder escapes them, including its HTML escaping.
+func isPrettyJSON(data, indent string) bool {
+	c := &prettyJSONChecker{data: data, indent: indent}
+	return c.value(0) && c.pos == len(data)
+}
+
+// prettyJSONChecker scans a document for isPrettyJSON.
+type prettyJSONChecker struct {
+	data   string
+	indent string
+	pos    int
+}
+
+func (c *prettyJSONChecker) expect(s string) bool {
+	if !strings.HasPrefix(c.data[c.pos:], s) {
+		return false
+	}
+	c.pos += len(s)
+	return true
+}
+
+// newline expects a line break followed by depth indents.
+func (c *prettyJSONChecker) newline(depth int) bool {
+	if !c.expect("\n") {
+		return false
+	}
+	for i := 0; i < depth; i++ {
+		if !c.expect(c.indent) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *prettyJSONChecker) value(depth int) bool {
+	if c.pos >= len(c.data) {
+		return false
+	}
+	switch c.data[c.pos] {
+	case '{':
+		return c.object(depth)
+	case '[':
+		return c.array(depth)
+	case '"':
+		_, ok := c.string()
+		return ok
+	case 't':
+		return c.expect("true")
+	case 'f':
+		return c.expect("false")
+	case 'n':
+		return c.expect("null")
+	default:
+		return c.number()
+	}
+}
+
+func (c *prettyJSONChecker) object(depth int) bool {
+	c.pos++
+	if c.expect("}") {
+		return true
+	}
+	var previous string
+	for first := true; ; first = false {
+		if !c.newline(depth + 1) {
+			return false
+		}
+		key, ok := c.string()
+		if !ok || !first && key <= previous {
+			return false
+		}
+		previous = key
+		if !c.expect(": ") || !c.value(depth+1) {
+			return false
+		}
+		if !c.expect(",") {
+			return c.newline(depth) && c.expect("}")
+		}
+	}
+}
+
+func (c *prettyJSONChecker) array(depth int) bool {
+	c.pos++
+	if c.expect("]") {
+		return true
+	}
+	for {
+		if !c.newline(depth+1) || !c.value(depth+1) {
+			return false
+		}
+		if !c.expect(",") {
+			return c.newline(depth) && c.expect("]")
+		}
+	}
+}
+
+// string scans a string and returns its decoded value.
+func (c *prettyJSONChecker) string() (string, bool) {
+	start := c.pos
+	c.pos++
+	escaped := false
+	for c.pos < len(c.data) {
+		b := c.data[c.pos]
+		switch {
+		case b == '"':
+			c.pos++
+			if !escaped {
+				return c.data[start+1 : c.pos-1], true
+			}
+			s, err := strconv.Unquote(c.data[start:c.pos])
+			return s, err == nil
+		case b == '\\':
+			if !c.escape() {
+				return "", false
+			}
+			escaped = true
+		case b < 0x20 || b == '<' || b == '>' || b == '&':
+			return "", false
+		case b < utf8.RuneSelf:
+			c.pos++
+		default:
+			r, size := utf8.DecodeRuneInString(c.data[c.pos:])
+			if r == utf8.RuneError && size == 1 || r == '\u2028' || r == '\u2029' {
+				return "", false
+			}
+			c.pos += size
+		}
+	}
+	return "", false
+}
+
+// escape scans an escape sequence, accepting only those the encoder writes.
+func (c *prettyJSONChecker) escape() bool {
+	rest := c.data[c.pos:]
+	if len(rest) < 2 {
+		return false
+	}
+	switch rest[1] {
+	case '"', '\\', 'b', 'f', 'n', 'r', 't':
+		c.pos += 2
+		return true
+	case 'u':
+		if len(rest) < 6 {
+			return false
+		}
+		switch code := rest[2:6]; code {
+		case "003c", "003e", "0026", "2028", "2029":
+		default:
+			n, err := strconv.ParseUint(code, 16, 16)
+			if err != nil || n >= 0x20 || strings.ContainsAny(code, "ABCDEF") || strings.ContainsRune("\b\f\n\r\t", rune(n)) {
+				return false
+			}
+		}
+		c.pos += 6
+		return true
+	}
+	return false
+}
+
+// number scans a number and checks that it is written as the encoder writes
+// its float64 value.
+func (c *prettyJSONChecker) number() bool {
+	start := c.pos
+	digits := func() int {
+		n := 0
+		for c.pos < len(c.data) && c.data[c.pos] >= '0' && c.data[c.pos] <= '9' {
+			c.pos++
+			n++
+		}
+		return n
+	}
+	c.expect("-")
+	if digits() == 0 {
+		return false
+	}
+	if c.expect(".") && digits() == 0 {
+		return false
+	}
+	if c.expect("e") || c.expect("E") {
+		_ = c.expect("+") || c.expect("-")
+		if digits() == 0 {
+			return false
+		}
+	}
+
+	text := c.data[start:c.pos]
+	f, err := strconv.ParseFloat(text, 64)
+	return err == nil && text == formatJSONFloat(f)
+}
+
+// formatJSONFloat formats a float64 as encoding/json does.
+func formatJSONFloat(f float64) string {
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b := strconv.AppendFloat(nil, f, format, -1, 64)
+	if format == 'e' {
+		// Shorten exponents such as e-07 to e-7.
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// pruneOptionNames lists the options that control which values are pruned.
+var pruneOptionNames = []string{"nulls", "empty_objects", "empty_arrays", "empty_strings", "exclude"}
+
+// pruneOptionsDescription documents the options shared by jsonprune and the
+// prune option of jsonprettyprint.
+const pruneOptionsDescription = "- `nulls` - Remove `null` values (default `true`)\n" +
+	"- `empty_objects` - Remove empty objects (default `true`)\n" +
+	"- `empty_arrays` - Remove empty arrays (default `true`)\n" +
+	"- `empty_strings` - Remove empty strings (default `false`)\n" +
+	"- `exclude` - JSON Pointers or glob-style paths of values that are kept unchanged, such as `[\"tags\"]`"
+
+// jsonPruner removes empty values from a document.
+type jsonPruner struct {
+	nulls        bool
+	emptyObjects bool
+	emptyArrays  bool
+	emptyStrings bool
+	exclude      jsonPathPatterns
+}
+
+// newJSONPruner reads the prune switches and exclusions from options.
+func newJSONPruner(opts functionOptions) (jsonPruner, *function.FuncError) {
+	var pruner jsonPruner
+	var funcErr *function.FuncError
+
+	for _, option := range []struct {
+		name  string
+		value *bool
+		def   bool
+	}{
+		{"nulls", &pruner.nulls, true},
+		{"empty_objects", &pruner.emptyObjects, true},
+		{"empty_arrays", &pruner.emptyArrays, true},
+		{"empty_strings", &pruner.emptyStrings, false},
+	} {
+		if *option.value, funcErr = opts.boolOption(option.name, option.def); funcErr != nil {
+			return pruner, funcErr
+		}
+	}
+
+	exclude, funcErr := opts.stringListOption("exclude")
+	if funcErr != nil {
+		return pruner, funcErr
+	}
+	patterns, err := parseJSONPathPatterns(exclude)
+	if err != nil {
+		return pruner, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf("Invalid option \"exclude\": %v.", err))
+	}
+	pruner.exclude = patterns
+	return pruner, nil
+}
+
+// parsePruneOption decodes the prune option of jsonprettyprint: true for the
+// default switches, or an object with the options of jsonprune. The result is
+// nil when nothing should be pruned.
+func parsePruneOption(ctx context.Context, value any, argumentPosition int64) (*jsonPruner, *function.FuncError) {
+	opts := functionOptions{values: newJSONObject(), argumentPosition: argumentPosition}
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		if !v {
+			return nil, nil
+		}
+	case *jsonObject:
+		if funcErr := validateOptionNames(ctx, v, argumentPosition, pruneOptionNames); funcErr != nil {
+			return nil, funcErr
+		}
+		opts.values = v
+	default:
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"Option \"prune\" must be a boolean or an object, got %s.", jsonTypeName(value)))
+	}
+
+	pruner, funcErr := newJSONPruner(opts)
+	if funcErr != nil {
+		return nil, funcErr
+	}
+	return &pruner, nil
+}
+
+// prune returns value with the pruned members and elements removed, and
+// reports whether value itself is to be removed from its parent. Containers
+// that become empty are removed too. Excluded values are returned unchanged.
+func (p jsonPruner) prune(value any, location jsonPointer) (any, bool) {
+	if p.exclude.Matches(location) {
+		return value, false
+	}
+
+	switch v := value.(type) {
+	case *jsonObject:
+		pruned := newJSONObject()
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			if member, remove := p.prune(member, location.child(key)); !remove {
+				pruned.Set(key, member)
+			}
+		}
+		return pruned, p.emptyObjects && pruned.Len() == 0
+	case []any:
+		pruned := []any{}
+		for i, element := range v {
+			if element, remove := p.prune(element, location.child(strconv.Itoa(i))); !remove {
+				pruned = append(pruned, element)
+			}
+		}
+		return pruned, p.emptyArrays && len(pruned) == 0
+	case nil:
+		return nil, p.nulls
+	case string:
+		return v, p.emptyStrings && v == ""
+	default:
+		return v, false
+	}
+}
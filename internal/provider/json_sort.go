@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Sort orders accepted by array sort rules.
+const (
+	SortOrderAscending  = "asc"
+	SortOrderDescending = "desc"
+)
+
+// arraySortRulesDescription documents the rules accepted by jsonsortarrays
+// and the sort_arrays option of jsonprettyprint.
+const arraySortRulesDescription = `## Sort Rules
+
+Each rule selects arrays with a ` + "`path`" + `: a JSON Pointer such as ` + "`\"/Statement/0/Action\"`" + ` or a glob-style path as in ` + "`jsonpick`" + `, such as ` + "`\"Statement[*].Action\"`" + ` or ` + "`\"**\"`" + ` for every array in the document. A rule is either a path string or an object with these fields:
+
+- ` + "`path`" + ` (required) - Arrays to sort
+- ` + "`by`" + ` - Sort keys for arrays of objects: a member name such as ` + "`\"name\"`" + `, a JSON Pointer such as ` + "`\"/metadata/name\"`" + `, an object ` + "`{ key = \"port\", order = \"desc\" }`" + `, or a list of them. Later keys break ties between elements that are equal in earlier keys.
+- ` + "`order`" + ` - ` + "`\"asc\"`" + ` (default) or ` + "`\"desc\"`" + `, for the values themselves and for keys without their own order
+
+Without ` + "`by`" + `, elements are sorted by value: ` + "`null`" + ` first, then booleans, numbers by numeric value, strings by Unicode code point, arrays and objects. Elements that lack a sort key are placed after the elements that have it. Sorting is stable, so elements that compare equal keep their original order, and the first rule whose path matches an array is used. Nested arrays are sorted before the arrays that contain them, and paths that do not select an array are ignored.`
+
+// jsonArraySortKey is a sort key of an array sort rule.
+type jsonArraySortKey struct {
+	pointer    jsonPointer
+	descending bool
+}
+
+// jsonArraySortRule sorts the arrays matched by path.
+type jsonArraySortRule struct {
+	path       jsonPathPattern
+	keys       []jsonArraySortKey
+	descending bool
+}
+
+// jsonArraySortRules is an ordered list of array sort rules.
+type jsonArraySortRules []jsonArraySortRule
+
+// parseArraySortRules decodes sort rules: a single rule or a list of rules,
+// where each rule is a path string or a rule object.
+func parseArraySortRules(value any) (jsonArraySortRules, error) {
+	list, ok := value.([]any)
+	if !ok {
+		switch value.(type) {
+		case string, *jsonObject:
+			list = []any{value}
+		default:
+			return nil, fmt.Errorf("rules must be a list of paths or rule objects, got %s", jsonTypeName(value))
+		}
+	}
+
+	rules := make(jsonArraySortRules, len(list))
+	for i, element := range list {
+		rule, err := parseArraySortRule(element)
+		if err != nil {
+			return nil, fmt.Errorf("rule at index %d: %w", i, err)
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
+func parseArraySortRule(value any) (jsonArraySortRule, error) {
+	var rule jsonArraySortRule
+
+	if path, ok := value.(string); ok {
+		pattern, err := parseJSONPathPattern(path)
+		rule.path = pattern
+		return rule, err
+	}
+
+	object, ok := value.(*jsonObject)
+	if !ok {
+		return rule, fmt.Errorf("rule must be a path or an object, got %s", jsonTypeName(value))
+	}
+	allowed := []string{"path", "by", "order"}
+	for _, field := range object.Keys() {
+		if !slices.Contains(allowed, field) {
+			return rule, fmt.Errorf("unknown field %q, valid fields are: %s", field, strings.Join(allowed, ", "))
+		}
+	}
+
+	path, ok := object.Get("path")
+	if !ok {
+		return rule, errors.New("field \"path\" is required")
+	}
+	pathString, isString := path.(string)
+	if !isString {
+		return rule, fmt.Errorf("field \"path\" must be a string, got %s", jsonTypeName(path))
+	}
+	pattern, err := parseJSONPathPattern(pathString)
+	if err != nil {
+		return rule, err
+	}
+	rule.path = pattern
+
+	if rule.descending, err = parseSortOrder(object, false); err != nil {
+		return rule, err
+	}
+
+	by, _ := object.Get("by")
+	keys, isList := by.([]any)
+	if !isList && by != nil {
+		keys = []any{by}
+	}
+	for i, key := range keys {
+		sortKey, err := parseArraySortKey(key, rule.descending)
+		if err != nil {
+			return rule, fmt.Errorf("field \"by\" at index %d: %w", i, err)
+		}
+		rule.keys = append(rule.keys, sortKey)
+	}
+	return rule, nil
+}
+
+func parseArraySortKey(value any, descending bool) (jsonArraySortKey, error) {
+	sortKey := jsonArraySortKey{descending: descending}
+
+	key, isString := value.(string)
+	if object, ok := value.(*jsonObject); ok {
+		for _, field := range object.Keys() {
+			if field != "key" && field != "order" {
+				return sortKey, fmt.Errorf("unknown field %q, valid fields are: key, order", field)
+			}
+		}
+		var member any
+		member, _ = object.Get("key")
+		key, isString = member.(string)
+		if !isString {
+			return sortKey, fmt.Errorf("field \"key\" must be a string, got %s", jsonTypeName(member))
+		}
+		var err error
+		if sortKey.descending, err = parseSortOrder(object, descending); err != nil {
+			return sortKey, err
+		}
+	} else if !isString {
+		return sortKey, fmt.Errorf("sort key must be a string or an object, got %s", jsonTypeName(value))
+	}
+
+	pointer, err := parseMemberPointer(key)
+	sortKey.pointer = pointer
+	return sortKey, err
+}
+
+// parseSortOrder reads the order field of a rule or sort key object and
+// reports whether it is descending.
+func parseSortOrder(object *jsonObject, descending bool) (bool, error) {
+	order, ok := object.Get("order")
+	if !ok || order == nil {
+		return descending, nil
+	}
+	switch order {
+	case SortOrderAscending:
+		return false, nil
+	case SortOrderDescending:
+		return true, nil
+	default:
+		return false, fmt.Errorf("field \"order\" must be %q or %q, got %s", SortOrderAscending, SortOrderDescending, compactJSONText(order))
+	}
+}
+
+// compactJSONText renders a document value for error messages.
+func compactJSONText(value any) string {
+	text, err := json.Marshal(value)
+	if err != nil {
+		return jsonTypeName(value)
+	}
+	return string(text)
+}
+
+// sort sorts every array in value whose location is matched by a rule. The
+// arrays are sorted in place.
+func (rs jsonArraySortRules) sort(value any, location jsonPointer) {
+	switch v := value.(type) {
+	case *jsonObject:
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			rs.sort(member, location.child(key))
+		}
+	case []any:
+		for i, element := range v {
+			rs.sort(element, location.child(strconv.Itoa(i)))
+		}
+		for _, rule := range rs {
+			if rule.path.Matches(location) {
+				rule.sortArray(v)
+				break
+			}
+		}
+	}
+}
+
+// sortArray stably sorts array by the rule's keys, or by value when the rule
+// has none.
+func (r jsonArraySortRule) sortArray(array []any) {
+	if len(r.keys) == 0 {
+		slices.SortStableFunc(array, func(a, b any) int {
+			if r.descending {
+				return compareJSONValues(b, a)
+			}
+			return compareJSONValues(a, b)
+		})
+		return
+	}
+
+	type keyedElement struct {
+		element any
+		values  []any
+		present []bool
+	}
+	keyed := make([]keyedElement, len(array))
+	for i, element := range array {
+		keyed[i] = keyedElement{element: element, values: make([]any, len(r.keys)), present: make([]bool, len(r.keys))}
+		if _, isObject := element.(*jsonObject); !isObject {
+			continue
+		}
+		for k, key := range r.keys {
+			value, err := key.pointer.Resolve(element)
+			keyed[i].values[k], keyed[i].present[k] = value, err == nil
+		}
+	}
+
+	slices.SortStableFunc(keyed, func(a, b keyedElement) int {
+		for k, key := range r.keys {
+			switch {
+			case a.present[k] && !b.present[k]:
+				return -1
+			case !a.present[k] && b.present[k]:
+				return 1
+			case !a.present[k]:
+				continue
+			}
+			c := compareJSONValues(a.values[k], b.values[k])
+			if key.descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	for i := range keyed {
+		array[i] = keyed[i].element
+	}
+}
+
+// jsonTypeRank orders values of different JSON types.
+func jsonTypeRank(value any) int {
+	switch value.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case json.Number:
+		return 2
+	case string:
+		return 3
+	case []any:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// compareJSONValues defines a total order on document values. Values of
+// different types are ordered by type, numbers by numeric value, strings by
+// code point, arrays element by element and objects by their sorted member
+// names and then their member values.
+func compareJSONValues(a, b any) int {
+	if ra, rb := jsonTypeRank(a), jsonTypeRank(b); ra != rb {
+		return ra - rb
+	}
+
+	switch av := a.(type) {
+	case bool:
+		bv, _ := b.(bool)
+		switch {
+		case av == bv:
+			return 0
+		case !av:
+			return -1
+		default:
+			return 1
+		}
+	case json.Number:
+		bv, _ := b.(json.Number)
+		return compareJSONNumbers(av, bv)
+	case string:
+		bv, _ := b.(string)
+		return strings.Compare(av, bv)
+	case []any:
+		bv, _ := b.([]any)
+		for i := 0; i < len(av) && i < len(bv); i++ {
+			if c := compareJSONValues(av[i], bv[i]); c != 0 {
+				return c
+			}
+		}
+		return len(av) - len(bv)
+	case *jsonObject:
+		bv, _ := b.(*jsonObject)
+		aKeys, bKeys := slices.Sorted(slices.Values(av.Keys())), slices.Sorted(slices.Values(bv.Keys()))
+		if c := slices.Compare(aKeys, bKeys); c != 0 {
+			return c
+		}
+		for _, key := range aKeys {
+			aMember, _ := av.Get(key)
+			bMember, _ := bv.Get(key)
+			if c := compareJSONValues(aMember, bMember); c != 0 {
+				return c
+			}
+		}
+		return 0
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONArrayDedupeFunction{}
+)
+
+func NewJSONArrayDedupeFunction() function.Function {
+	return JSONArrayDedupeFunction{}
+}
+
+type JSONArrayDedupeFunction struct{}
+
+func (r JSONArrayDedupeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonarraydedupe")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonarraydedupe"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONArrayDedupeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonarraydedupe")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Remove duplicate elements from a JSON array",
+		MarkdownDescription: `Removes duplicate elements from a JSON array and returns the pretty-printed result. Unlike ` + "`distinct()`" + `, it works on arbitrary nested JSON values, including arrays of mixed types.
+
+` + arraySetIdentityDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "array",
+				MarkdownDescription: "The JSON array to deduplicate.\n\n**Requirements:**\n- Must be a valid JSON array\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: arraySetOptionsDescription,
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONArrayDedupeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonarraydedupe")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON array dedupe function execution")
+
+	var arrayString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arrayString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	set, indent, funcErr := parseArraySetOptions(ctx, options, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	array, funcErr := parseJSONArrayArgument(ctx, arrayString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resultArray := set.dedupe(array)
+
+	result, funcErr := formatJSONResult(ctx, resultArray, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON array dedupe function execution successful", map[string]any{
+		"result_size":   len(result),
+		"element_count": len(resultArray),
+		"keyed":         len(set.key) > 0,
+	})
+}
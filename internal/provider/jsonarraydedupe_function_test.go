@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for deep equality.
+func TestJSONArrayDedupeFunction_DeepEquality(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_scalars_and_objects" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarraydedupe("[1,\"1\",1.0,{\"a\":1,\"b\":[1,2]},{\"b\":[1,2],\"a\":1},{\"b\":[2,1],\"a\":1},null,null,true]")))
+				}
+				output "test_formatting" {
+					value = provider::prettyjson::jsonarraydedupe("[[1],[1]]", { indentation_type = "tab" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_scalars_and_objects", `[1,"1",{"a":1,"b":[1,2]},{"a":1,"b":[2,1]},null,true]`),
+					resource.TestCheckOutput("test_formatting", "[\n\t[\n\t\t1\n\t]\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for identity keys.
+func TestJSONArrayDedupeFunction_IdentityKey(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					statements = "[{\"Sid\":\"Read\",\"Action\":\"s3:GetObject\"},{\"Sid\":\"Write\",\"Action\":\"s3:PutObject\"},{\"Sid\":\"Read\",\"Action\":[\"s3:GetObject\",\"s3:ListBucket\"]},{\"Action\":\"s3:*\"}]"
+				}
+				output "test_key_first" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarraydedupe(local.statements, { key = "Sid" })))
+				}
+				output "test_key_last" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarraydedupe(local.statements, { key = "/Sid", keep = "last" })))
+				}
+				output "test_composite_key" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarraydedupe("[{\"p\":\"tcp\",\"port\":443,\"d\":\"a\"},{\"p\":\"tcp\",\"port\":443,\"d\":\"b\"},{\"p\":\"udp\",\"port\":443}]", { key = ["p", "port"] })))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_key_first", `[{"Action":"s3:GetObject","Sid":"Read"},{"Action":"s3:PutObject","Sid":"Write"},{"Action":"s3:*"}]`),
+					resource.TestCheckOutput("test_key_last", `[{"Action":["s3:GetObject","s3:ListBucket"],"Sid":"Read"},{"Action":"s3:PutObject","Sid":"Write"},{"Action":"s3:*"}]`),
+					resource.TestCheckOutput("test_composite_key", `[{"d":"a","p":"tcp","port":443},{"p":"udp","port":443}]`),
+				),
+			},
+			{
+				Config: `
+				output "test_not_an_array" {
+					value = provider::prettyjson::jsonarraydedupe("{}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Document\s+must\s+be\s+a\s+JSON\s+array,\s+got\s+object`),
+			},
+			{
+				Config: `
+				output "test_invalid_key" {
+					value = provider::prettyjson::jsonarraydedupe("[]", { key = 1 })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Option\s+"key"\s+must\s+be\s+a\s+string\s+or\s+a\s+non-empty\s+list\s+of\s+strings`),
+			},
+		},
+	})
+}
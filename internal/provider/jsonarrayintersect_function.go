@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONArrayIntersectFunction{}
+)
+
+func NewJSONArrayIntersectFunction() function.Function {
+	return JSONArrayIntersectFunction{}
+}
+
+type JSONArrayIntersectFunction struct{}
+
+func (r JSONArrayIntersectFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonarrayintersect")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonarrayintersect"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONArrayIntersectFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonarrayintersect")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Intersection of JSON arrays with deep equality",
+		MarkdownDescription: `Returns the intersection of JSON arrays: the distinct elements of the first array that also occur in every other array, in the order of the first array.
+
+` + arraySetIdentityDescription,
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "arrays",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The JSON arrays to intersect. Each must be a valid JSON array of at most 10MB.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: arraySetOptionsDescription,
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONArrayIntersectFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonarrayintersect")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON array intersect function execution")
+
+	var arrayStrings []string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arrayStrings, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	set, indent, funcErr := parseArraySetOptions(ctx, options, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	arrays, funcErr := parseJSONArrayListArgument(ctx, arrayStrings, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resultArray := set.intersect(arrays)
+
+	result, funcErr := formatJSONResult(ctx, resultArray, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON array intersect function execution successful", map[string]any{
+		"result_size":   len(result),
+		"element_count": len(resultArray),
+		"keyed":         len(set.key) > 0,
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for intersections.
+func TestJSONArrayIntersectFunction_Intersect(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_deep_equality" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarrayintersect(["[{\"a\":1,\"b\":2},3,3,\"x\",[1]]", "[[1],{\"b\":2,\"a\":1},3]", "[3,{\"a\":1,\"b\":2},[1],4]"])))
+				}
+				output "test_key" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarrayintersect(["[{\"name\":\"a\",\"v\":1},{\"name\":\"b\",\"v\":1}]", "[{\"name\":\"a\",\"v\":2}]"], { key = "name" })))
+				}
+				output "test_single_array" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarrayintersect(["[1,1,2]"])))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_deep_equality", `[{"a":1,"b":2},3,[1]]`),
+					resource.TestCheckOutput("test_key", `[{"name":"a","v":1}]`),
+					resource.TestCheckOutput("test_single_array", `[1,2]`),
+				),
+			},
+		},
+	})
+}
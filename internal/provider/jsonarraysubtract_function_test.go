@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for subtraction.
+func TestJSONArraySubtractFunction_Subtract(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_deep_equality" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarraysubtract("[1,2,2,{\"a\":{\"b\":1}},\"x\"]", "[2,{\"a\":{\"b\":1.0}}]")))
+				}
+				output "test_key" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarraysubtract("[{\"id\":1,\"v\":\"a\"},{\"id\":2,\"v\":\"b\"},\"plain\"]", "[{\"id\":1,\"v\":\"changed\"},\"plain\"]", { key = "id" })))
+				}
+				output "test_nothing_left" {
+					value = provider::prettyjson::jsonarraysubtract("[1]", "[1]")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_deep_equality", `[1,"x"]`),
+					resource.TestCheckOutput("test_key", `[{"id":2,"v":"b"}]`),
+					resource.TestCheckOutput("test_nothing_left", "[]"),
+				),
+			},
+			{
+				Config: `
+				output "test_remove_not_an_array" {
+					value = provider::prettyjson::jsonarraysubtract("[1]", "{}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"remove"\s+parameter:\s+Document\s+must\s+be\s+a\s+JSON\s+array`),
+			},
+			{
+				Config: `
+				output "test_invalid_keep" {
+					value = provider::prettyjson::jsonarraysubtract("[]", "[]", { keep = "middle" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+"middle"\s+for\s+option\s+"keep"`),
+			},
+		},
+	})
+}
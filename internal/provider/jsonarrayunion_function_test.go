@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for unions.
+func TestJSONArrayUnionFunction_Union(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					base  = jsonencode([{ protocol = "tcp", from_port = 443, to_port = 443, cidr = "10.0.0.0/8" }, { protocol = "tcp", from_port = 22, to_port = 22, cidr = "10.0.0.0/8" }])
+					extra = jsonencode([{ protocol = "tcp", from_port = 443, to_port = 443, cidr = "0.0.0.0/0" }, { protocol = "udp", from_port = 53, to_port = 53, cidr = "10.0.0.0/8" }])
+				}
+				output "test_deep_equality" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonarrayunion(["[1,{\"a\":[1]}]", "[{\"a\":[1.0]},2,1]", "[3]"])))
+				}
+				output "test_key_last" {
+					value = jsonencode([for r in jsondecode(provider::prettyjson::jsonarrayunion([local.base, local.extra], { key = ["protocol", "from_port", "to_port"], keep = "last" })) : "${r.protocol}/${r.from_port}/${r.cidr}"])
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_deep_equality", `[1,{"a":[1]},2,3]`),
+					resource.TestCheckOutput("test_key_last", `["tcp/443/0.0.0.0/0","tcp/22/10.0.0.0/8","udp/53/10.0.0.0/8"]`),
+				),
+			},
+			{
+				Config: `
+				output "test_empty_list" {
+					value = provider::prettyjson::jsonarrayunion([])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`At\s+least\s+one\s+JSON\s+array\s+must\s+be\s+provided`),
+			},
+			{
+				Config: `
+				output "test_not_an_array" {
+					value = provider::prettyjson::jsonarrayunion(["[]", "1"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Document\s+at\s+index\s+1:\s+Document\s+must\s+be\s+a\s+JSON\s+array,\s+got\s+number`),
+			},
+		},
+	})
+}
@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONCELFunction{}
+)
+
+// Limits applied to CEL expressions.
+const (
+	CELExecutionTimeout = 10 * time.Second
+	CELCostLimit        = 100_000_000
+)
+
+// CELDocumentVariable is the variable the document is bound to.
+const CELDocumentVariable = "self"
+
+var celVariableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func NewJSONCELFunction() function.Function {
+	return JSONCELFunction{}
+}
+
+type JSONCELFunction struct{}
+
+func (r JSONCELFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsoncel")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsoncel"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONCELFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsoncel")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Evaluate a Common Expression Language expression against a JSON document",
+		MarkdownDescription: `Evaluates a [Common Expression Language](https://cel.dev) (CEL) expression against a JSON document and returns the result as pretty-printed JSON.
+
+## Overview
+
+CEL is the expression language of Kubernetes validation rules, admission policies and Google Cloud IAM conditions, so policy checks written for those systems can be evaluated in Terraform unchanged. The document is available as ` + "`self`" + `:
+
+- ` + "`self.spec.replicas >= 2`" + ` returns ` + "`true`" + ` or ` + "`false`" + `
+- ` + "`self.items.filter(i, i.enabled).map(i, i.name)`" + ` returns a JSON array
+- ` + "`has(self.metadata.labels) ? self.metadata.labels : {}`" + ` returns a JSON object
+
+The standard library is extended with the string, list, set, math and encoder extensions and optional types. JSON integers that fit in 64 bits are ` + "`int`" + ` values, all other numbers are ` + "`double`" + ` values.
+
+## Results
+
+Booleans, numbers, strings, lists and maps are returned as their JSON equivalents, and map keys are sorted. Bytes are returned base64 encoded, timestamps as RFC 3339 strings and durations in their CEL string form such as ` + "`\"90s\"`" + `.
+
+## Errors
+
+Syntax and type errors are reported with their line and column in the expression, for example ` + "`found no matching overload for '_+_' applied to '(int, string)'`" + `. Because the document is dynamically typed, many type errors are only found during evaluation; these are reported with their position as well. Evaluation is limited to 10 seconds.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document the expression is evaluated against, available as `self`.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "The CEL expression.\n\n**Example:** `\"self.spec.replicas >= 2 && self.metadata.name.startsWith('api-')\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `variables` - Object of additional variables for the expression. Keys must be CEL identifiers.\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ variables = { min_replicas = 2 } }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONCELFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsoncel")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting CEL function execution")
+
+	var documentString string
+	var expression string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &expression, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 2, "variables", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	variables, funcErr := opts.celVariables()
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	env, ast, funcErr := compileCELExpression(ctx, expression, variables, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	variables[CELDocumentVariable] = jsonValueToCEL(document)
+
+	value, err := evaluateCELExpression(ctx, env, ast, variables)
+	if err != nil {
+		tflog.Error(ctx, "CEL evaluation failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "CEL_EVALUATION_FAILED",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewFuncError(fmt.Sprintf("CEL evaluation failed: %v.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, value, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "CEL function execution successful", map[string]any{
+		"result_size": len(result),
+		"result_type": jsonTypeName(value),
+	})
+}
+
+// celVariables returns the variables option converted to CEL values.
+func (o functionOptions) celVariables() (map[string]any, *function.FuncError) {
+	variables := map[string]any{}
+
+	value, ok := o.values.Get("variables")
+	if !ok || value == nil {
+		return variables, nil
+	}
+	object, ok := value.(*jsonObject)
+	if !ok {
+		return nil, function.NewArgumentFuncError(o.argumentPosition, fmt.Sprintf(
+			"Option \"variables\" must be an object, got %s.", jsonTypeName(value)))
+	}
+	for _, name := range object.Keys() {
+		if !celVariableNamePattern.MatchString(name) || name == CELDocumentVariable {
+			return nil, function.NewArgumentFuncError(o.argumentPosition, fmt.Sprintf(
+				"Invalid variable name %q, names must be CEL identifiers other than %q.", name, CELDocumentVariable))
+		}
+		member, _ := object.Get(name)
+		variables[name] = jsonValueToCEL(member)
+	}
+	return variables, nil
+}
+
+// compileCELExpression parses and type-checks an expression. The document
+// and the extra variables are declared as dyn, so most type errors involving
+// them surface during evaluation instead.
+func compileCELExpression(ctx context.Context, expression string, variables map[string]any, argumentPosition int64) (*cel.Env, *cel.Ast, *function.FuncError) {
+	envOptions := []cel.EnvOption{
+		cel.Variable(CELDocumentVariable, cel.DynType),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+		ext.Math(),
+		ext.Encoders(),
+		cel.OptionalTypes(),
+	}
+	for name := range variables {
+		envOptions = append(envOptions, cel.Variable(name, cel.DynType))
+	}
+
+	env, err := cel.NewEnv(envOptions...)
+	if err != nil {
+		return nil, nil, function.NewFuncError(fmt.Sprintf("Failed to create CEL environment: %v.", err))
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		messages := make([]string, 0, len(issues.Errors()))
+		for _, issue := range issues.Errors() {
+			messages = append(messages, fmt.Sprintf("at line %d, column %d: %s",
+				issue.Location.Line(), issue.Location.Column()+1, issue.Message))
+		}
+		tflog.Error(ctx, "Invalid CEL expression", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "CEL_COMPILE_ERROR",
+			"error":      issues.Err().Error(),
+		})
+		return nil, nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"Invalid CEL expression %s.", strings.Join(messages, "; ")))
+	}
+	return env, ast, nil
+}
+
+// evaluateCELExpression evaluates a compiled expression within the execution
+// time and cost limits and converts the result to the document model.
+// Evaluation errors are prefixed with their position in the expression.
+func evaluateCELExpression(ctx context.Context, env *cel.Env, ast *cel.Ast, variables map[string]any) (any, error) {
+	program, err := env.Program(ast,
+		cel.CostLimit(CELCostLimit),
+		cel.InterruptCheckFrequency(100),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, CELExecutionTimeout)
+	defer cancel()
+
+	out, _, err := program.ContextEval(runCtx, variables)
+	if err != nil {
+		if errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("expression exceeded the execution time limit of %s", CELExecutionTimeout)
+		}
+		var evalErr *celtypes.Err
+		if errors.As(err, &evalErr) && evalErr.NodeID() != 0 {
+			location := ast.NativeRep().SourceInfo().GetStartLocation(evalErr.NodeID())
+			if location.Line() > 0 {
+				return nil, fmt.Errorf("at line %d, column %d: %w", location.Line(), location.Column()+1, err)
+			}
+		}
+		return nil, err
+	}
+
+	return celValueToJSON(out)
+}
+
+// jsonValueToCEL converts a document value to the native values CEL adapts.
+// Integers that fit in 64 bits become int so that arithmetic and comparisons
+// with integer literals work as expected.
+func jsonValueToCEL(value any) any {
+	switch v := value.(type) {
+	case *jsonObject:
+		converted := make(map[string]any, v.Len())
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			converted[key] = jsonValueToCEL(member)
+		}
+		return converted
+	case []any:
+		converted := make([]any, len(v))
+		for i, element := range v {
+			converted[i] = jsonValueToCEL(element)
+		}
+		return converted
+	case json.Number:
+		if !strings.ContainsAny(string(v), ".eE") {
+			if i, err := v.Int64(); err == nil {
+				return i
+			}
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// celValueToJSON converts a CEL result to the document model.
+func celValueToJSON(value ref.Val) (any, error) {
+	switch v := value.(type) {
+	case celtypes.Null:
+		return nil, nil
+	case celtypes.Bool:
+		return bool(v), nil
+	case celtypes.Int:
+		return json.Number(strconv.FormatInt(int64(v), 10)), nil
+	case celtypes.Uint:
+		return json.Number(strconv.FormatUint(uint64(v), 10)), nil
+	case celtypes.Double:
+		f := float64(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("result contains %v, which cannot be represented in JSON", f)
+		}
+		return json.Number(formatJSONFloat(f)), nil
+	case celtypes.String:
+		return string(v), nil
+	case celtypes.Bytes:
+		return base64.StdEncoding.EncodeToString(v), nil
+	case celtypes.Timestamp:
+		return v.UTC().Format(time.RFC3339Nano), nil
+	case celtypes.Duration:
+		return fmt.Sprint(v.ConvertToType(celtypes.StringType).Value()), nil
+	case *celtypes.Optional:
+		if !v.HasValue() {
+			return nil, nil
+		}
+		return celValueToJSON(v.GetValue())
+	case traits.Mapper:
+		var keys []string
+		for it := v.Iterator(); it.HasNext() == celtypes.True; {
+			key, ok := it.Next().(celtypes.String)
+			if !ok {
+				return nil, fmt.Errorf("result contains a map with non-string keys, which cannot be represented in JSON")
+			}
+			keys = append(keys, string(key))
+		}
+		sort.Strings(keys)
+		object := newJSONObject()
+		for _, key := range keys {
+			member, err := celValueToJSON(v.Get(celtypes.String(key)))
+			if err != nil {
+				return nil, err
+			}
+			object.Set(key, member)
+		}
+		return object, nil
+	case traits.Lister:
+		array := []any{}
+		for it := v.Iterator(); it.HasNext() == celtypes.True; {
+			element, err := celValueToJSON(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, element)
+		}
+		return array, nil
+	default:
+		return nil, fmt.Errorf("result of type %s cannot be represented in JSON", value.Type().TypeName())
+	}
+}
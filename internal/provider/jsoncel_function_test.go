@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for expression results.
+func TestJSONCELFunction_Results(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"metadata\":{\"name\":\"api-gateway\"},\"spec\":{\"replicas\":3,\"ratio\":0.5},\"items\":[{\"name\":\"a\",\"enabled\":true},{\"name\":\"b\",\"enabled\":false},{\"name\":\"c\",\"enabled\":true}]}"
+				}
+				output "test_policy" {
+					value = provider::prettyjson::jsoncel(local.doc, "self.spec.replicas >= 2 && self.metadata.name.startsWith('api-')")
+				}
+				output "test_arithmetic" {
+					value = provider::prettyjson::jsoncel(local.doc, "self.spec.replicas * 2 + 1")
+				}
+				output "test_double" {
+					value = provider::prettyjson::jsoncel(local.doc, "self.spec.ratio * 3.0")
+				}
+				output "test_large_double" {
+					value = provider::prettyjson::jsoncel(local.doc, "[5e7 * 2.0, 5e-7, 1e21]")
+				}
+				output "test_list" {
+					value = provider::prettyjson::jsoncel(local.doc, "self.items.filter(i, i.enabled).map(i, i.name)")
+				}
+				output "test_map_sorted_keys" {
+					value = provider::prettyjson::jsoncel(local.doc, "{'replicas': self.spec.replicas, 'name': self.metadata.name}")
+				}
+				output "test_has" {
+					value = provider::prettyjson::jsoncel(local.doc, "has(self.metadata.labels) ? self.metadata.labels : null")
+				}
+				output "test_extensions" {
+					value = provider::prettyjson::jsoncel(local.doc, "self.metadata.name.split('-').join('_').upperAscii()")
+				}
+				output "test_timestamp" {
+					value = provider::prettyjson::jsoncel(local.doc, "timestamp('2024-01-01T00:00:00Z') + duration('90m')")
+				}
+				output "test_4spaces" {
+					value = provider::prettyjson::jsoncel(local.doc, "[1, 2]", { indentation_type = "4spaces" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_policy", "true"),
+					resource.TestCheckOutput("test_arithmetic", "7"),
+					resource.TestCheckOutput("test_double", "1.5"),
+					resource.TestCheckOutput("test_large_double", "[\n  100000000,\n  5e-7,\n  1e+21\n]"),
+					resource.TestCheckOutput("test_list", "[\n  \"a\",\n  \"c\"\n]"),
+					resource.TestCheckOutput("test_map_sorted_keys", "{\n  \"name\": \"api-gateway\",\n  \"replicas\": 3\n}"),
+					resource.TestCheckOutput("test_has", "null"),
+					resource.TestCheckOutput("test_extensions", "\"API_GATEWAY\""),
+					resource.TestCheckOutput("test_timestamp", "\"2024-01-01T01:30:00Z\""),
+					resource.TestCheckOutput("test_4spaces", "[\n    1,\n    2\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for expression variables.
+func TestJSONCELFunction_Variables(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_variables" {
+					value = provider::prettyjson::jsoncel(
+						"{\"replicas\":3,\"region\":\"eu-west-1\"}",
+						"self.replicas >= min_replicas && self.region in regions",
+						{ variables = { min_replicas = 2, regions = ["eu-west-1", "eu-central-1"] } }
+					)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_variables", "true"),
+				),
+			},
+			{
+				Config: `
+				output "test_reserved_variable" {
+					value = provider::prettyjson::jsoncel("{}", "self", { variables = { self = 1 } })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+variable\s+name\s+"self"`),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONCELFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_compile_type_error" {
+					value = provider::prettyjson::jsoncel("{}", "1 + 'a'")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+CEL\s+expression\s+at\s+line\s+1,\s+column\s+3:\s+found\s+no\s+matching\s+overload`),
+			},
+			{
+				Config: `
+				output "test_syntax_error" {
+					value = provider::prettyjson::jsoncel("{}", "self.name +")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+CEL\s+expression\s+at\s+line\s+1,\s+column\s+12:\s+Syntax\s+error`),
+			},
+			{
+				Config: `
+				output "test_runtime_type_error" {
+					value = provider::prettyjson::jsoncel("{\"name\":\"api\"}", "self.name + 1")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`CEL\s+evaluation\s+failed:\s+at\s+line\s+1,\s+column\s+11:\s+no\s+such\s+overload`),
+			},
+			{
+				Config: `
+				output "test_missing_key" {
+					value = provider::prettyjson::jsoncel("{}", "self.spec")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`at\s+line\s+1,\s+column\s+5:\s+no\s+such\s+key:\s+spec`),
+			},
+			{
+				Config: `
+				output "test_invalid_document" {
+					value = provider::prettyjson::jsoncel("{invalid}", "self")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+syntax\s+detected`),
+			},
+		},
+	})
+}
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONDeepMergeFunction{}
+)
+
+// Array merge strategies supported by jsondeepmerge.
+const (
+	ArrayStrategyReplace      = "replace"
+	ArrayStrategyAppend       = "append"
+	ArrayStrategyUniqueAppend = "unique-append"
+	ArrayStrategyMergeByKey   = "merge-by-key"
+)
+
+// Type conflict policies supported by jsondeepmerge.
+const (
+	TypeConflictOverride = "override"
+	TypeConflictError    = "error"
+)
+
+func NewJSONDeepMergeFunction() function.Function {
+	return JSONDeepMergeFunction{}
+}
+
+type JSONDeepMergeFunction struct{}
+
+func (r JSONDeepMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsondeepmerge")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsondeepmerge"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONDeepMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsondeepmerge")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Deep merge a list of JSON documents",
+		MarkdownDescription: `Deep merges a list of JSON documents from left to right and returns the pretty-printed result.
+
+## Overview
+
+Each document is merged into the result of the documents before it, so later layers (for example base, then region, then environment) take precedence:
+
+- **Objects** merge recursively. Existing keys keep their position and new keys are appended.
+- **Scalars** (strings, numbers, booleans and null) from later documents replace earlier values.
+- **Arrays** are combined according to the ` + "`array_strategy`" + ` option.
+
+## Array Strategies
+
+- **replace** (default): the later array replaces the earlier one
+- **append**: elements of the later array are appended
+- **unique-append**: elements of the later array are appended unless a deeply equal element is already present
+- **merge-by-key**: objects with the same value for ` + "`merge_key`" + ` are merged recursively; other elements are appended
+
+## Type Conflicts
+
+When the same path holds different JSON types in two documents (for example an object and a string), the ` + "`on_type_conflict`" + ` option decides the outcome. ` + "`override`" + ` (default) lets the later value win, ` + "`error`" + ` fails with the JSON Pointer of the conflicting path. A ` + "`null`" + ` value never counts as a conflict, even with ` + "`error`" + `: a ` + "`null`" + ` in a later document replaces any earlier value, such as an object or a number, and any value replaces an earlier ` + "`null`" + `.`,
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "documents",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The JSON documents to merge, in increasing order of precedence. Each document must be valid JSON and at most 10MB.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with merge options:\n\n- `array_strategy` - `\"replace\"` (default), `\"append\"`, `\"unique-append\"` or `\"merge-by-key\"`\n- `merge_key` - Object key used to match array elements with `merge-by-key` (default `\"name\"`)\n- `on_type_conflict` - `\"override\"` (default) or `\"error\"`\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ array_strategy = \"merge-by-key\", merge_key = \"name\", on_type_conflict = \"error\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONDeepMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsondeepmerge")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON deep merge function execution")
+
+	var documentStrings []string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentStrings, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "array_strategy", "merge_key", "on_type_conflict", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	merger := jsonDeepMerger{}
+	merger.arrayStrategy, funcErr = opts.stringOption("array_strategy", ArrayStrategyReplace,
+		ArrayStrategyReplace, ArrayStrategyAppend, ArrayStrategyUniqueAppend, ArrayStrategyMergeByKey)
+	if funcErr == nil {
+		merger.mergeKey, funcErr = opts.stringOption("merge_key", "name")
+	}
+	if funcErr == nil {
+		merger.onTypeConflict, funcErr = opts.stringOption("on_type_conflict", TypeConflictOverride,
+			TypeConflictOverride, TypeConflictError)
+	}
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if len(documentStrings) == 0 {
+		tflog.Error(ctx, "No documents provided", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "EMPTY_DOCUMENT_LIST",
+		})
+		resp.Error = function.NewArgumentFuncError(0, "At least one JSON document must be provided.")
+		return
+	}
+
+	tflog.Debug(ctx, "Merging documents", map[string]any{
+		"document_count":   len(documentStrings),
+		"array_strategy":   merger.arrayStrategy,
+		"on_type_conflict": merger.onTypeConflict,
+	})
+
+	var merged any
+	for i, documentString := range documentStrings {
+		document, funcErr := parseJSONArgument(ctx, documentString, 0)
+		if funcErr != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Document at index %d: %s", i, funcErr.Text))
+			return
+		}
+
+		if i == 0 {
+			merged = document
+			continue
+		}
+
+		var err error
+		merged, err = merger.merge(merged, document, jsonPointer{}, i)
+		if err != nil {
+			tflog.Error(ctx, "JSON deep merge failed", map[string]any{
+				"error_type":     ErrorTypeProcessing,
+				"error_code":     "TYPE_CONFLICT",
+				"document_index": i,
+				"error":          err.Error(),
+			})
+			resp.Error = function.NewFuncError(err.Error())
+			return
+		}
+	}
+
+	result, funcErr := formatJSONResult(ctx, merged, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON deep merge function execution successful", map[string]any{
+		"result_size":    len(result),
+		"document_count": len(documentStrings),
+	})
+}
+
+// jsonDeepMerger merges document layers according to the jsondeepmerge
+// options.
+type jsonDeepMerger struct {
+	arrayStrategy  string
+	mergeKey       string
+	onTypeConflict string
+}
+
+// merge merges overlay into base. Both values are freshly parsed documents
+// owned by the caller, so base is updated in place.
+func (m jsonDeepMerger) merge(base, overlay any, path jsonPointer, documentIndex int) (any, error) {
+	// null is not a type conflict under either policy, so a later null
+	// removes a value and a later value fills an earlier null.
+	if base == nil || overlay == nil {
+		return overlay, nil
+	}
+
+	switch overlayValue := overlay.(type) {
+	case *jsonObject:
+		baseObject, ok := base.(*jsonObject)
+		if !ok {
+			return m.conflict(base, overlay, path, documentIndex)
+		}
+		for _, key := range overlayValue.Keys() {
+			value, _ := overlayValue.Get(key)
+			current, exists := baseObject.Get(key)
+			if !exists {
+				baseObject.Set(key, value)
+				continue
+			}
+			merged, err := m.merge(current, value, append(path[:len(path):len(path)], key), documentIndex)
+			if err != nil {
+				return nil, err
+			}
+			baseObject.Set(key, merged)
+		}
+		return baseObject, nil
+	case []any:
+		baseArray, ok := base.([]any)
+		if !ok {
+			return m.conflict(base, overlay, path, documentIndex)
+		}
+		return m.mergeArrays(baseArray, overlayValue, path, documentIndex)
+	default:
+		if jsonTypeName(base) != jsonTypeName(overlay) {
+			return m.conflict(base, overlay, path, documentIndex)
+		}
+		return overlay, nil
+	}
+}
+
+func (m jsonDeepMerger) mergeArrays(base, overlay []any, path jsonPointer, documentIndex int) (any, error) {
+	switch m.arrayStrategy {
+	case ArrayStrategyAppend:
+		return append(base, overlay...), nil
+	case ArrayStrategyUniqueAppend:
+		for _, element := range overlay {
+			if !containsJSONValue(base, element) {
+				base = append(base, element)
+			}
+		}
+		return base, nil
+	case ArrayStrategyMergeByKey:
+		for _, element := range overlay {
+			index := m.keyedElementIndex(base, element)
+			if index < 0 {
+				base = append(base, element)
+				continue
+			}
+			elementPath := append(path[:len(path):len(path)], fmt.Sprint(index))
+			merged, err := m.merge(base[index], element, elementPath, documentIndex)
+			if err != nil {
+				return nil, err
+			}
+			base[index] = merged
+		}
+		return base, nil
+	default:
+		return overlay, nil
+	}
+}
+
+// keyedElementIndex returns the index of the element in base whose merge key
+// matches that of element, or -1 when element has no key or no match.
+func (m jsonDeepMerger) keyedElementIndex(base []any, element any) int {
+	object, ok := element.(*jsonObject)
+	if !ok {
+		return -1
+	}
+	key, ok := object.Get(m.mergeKey)
+	if !ok {
+		return -1
+	}
+	for i, candidate := range base {
+		candidateObject, ok := candidate.(*jsonObject)
+		if !ok {
+			continue
+		}
+		if candidateKey, ok := candidateObject.Get(m.mergeKey); ok && jsonValuesEqual(candidateKey, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m jsonDeepMerger) conflict(base, overlay any, path jsonPointer, documentIndex int) (any, error) {
+	if m.onTypeConflict == TypeConflictError {
+		return nil, fmt.Errorf("type conflict at path %q: document at index %d has %s where earlier documents have %s",
+			path.String(), documentIndex, jsonTypeName(overlay), jsonTypeName(base))
+	}
+	return overlay, nil
+}
+
+// containsJSONValue reports whether values contains an element deeply equal
+// to value.
+func containsJSONValue(values []any, value any) bool {
+	for _, candidate := range values {
+		if jsonValuesEqual(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
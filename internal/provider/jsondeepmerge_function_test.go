@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for layered object merging.
+func TestJSONDeepMergeFunction_Basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_layers" {
+					value = provider::prettyjson::jsondeepmerge([
+						"{\"service\":\"api\",\"replicas\":1,\"limits\":{\"cpu\":\"1\",\"memory\":\"1Gi\"}}",
+						"{\"region\":\"eu-west-1\",\"limits\":{\"cpu\":\"2\"}}",
+						"{\"replicas\":3}",
+					])
+				}
+				output "test_single_document" {
+					value = provider::prettyjson::jsondeepmerge(["{\"b\":1,\"a\":2}"])
+				}
+				output "test_null_overrides" {
+					value = provider::prettyjson::jsondeepmerge(["{\"a\":{\"b\":1}}", "{\"a\":null}"])
+				}
+				output "test_indentation" {
+					value = provider::prettyjson::jsondeepmerge(["{\"a\":{\"b\":1}}", "{\"a\":{\"c\":2}}"], { indentation_type = "tab" })
+				}
+				output "test_null_options" {
+					value = provider::prettyjson::jsondeepmerge(["{\"a\":1}"], null)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_layers", "{\n  \"service\": \"api\",\n  \"replicas\": 3,\n  \"limits\": {\n    \"cpu\": \"2\",\n    \"memory\": \"1Gi\"\n  },\n  \"region\": \"eu-west-1\"\n}"),
+					resource.TestCheckOutput("test_single_document", "{\n  \"b\": 1,\n  \"a\": 2\n}"),
+					resource.TestCheckOutput("test_null_overrides", "{\n  \"a\": null\n}"),
+					resource.TestCheckOutput("test_indentation", "{\n\t\"a\": {\n\t\t\"b\": 1,\n\t\t\"c\": 2\n\t}\n}"),
+					resource.TestCheckOutput("test_null_options", "{\n  \"a\": 1\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for each array strategy.
+func TestJSONDeepMergeFunction_ArrayStrategies(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					tags_base    = "{\"tags\":[\"a\",\"b\"]}"
+					tags_overlay = "{\"tags\":[\"b\",\"c\"]}"
+				}
+				output "test_replace" {
+					value = provider::prettyjson::jsondeepmerge([local.tags_base, local.tags_overlay])
+				}
+				output "test_append" {
+					value = provider::prettyjson::jsondeepmerge([local.tags_base, local.tags_overlay], { array_strategy = "append" })
+				}
+				output "test_unique_append" {
+					value = provider::prettyjson::jsondeepmerge([local.tags_base, local.tags_overlay, "{\"tags\":[{\"x\":1},{\"x\":1.0}]}"], { array_strategy = "unique-append" })
+				}
+				output "test_merge_by_key" {
+					value = provider::prettyjson::jsondeepmerge([
+						"{\"containers\":[{\"name\":\"app\",\"image\":\"app:1\",\"env\":{\"A\":\"1\"}},{\"name\":\"sidecar\",\"image\":\"proxy:1\"}]}",
+						"{\"containers\":[{\"name\":\"app\",\"image\":\"app:2\",\"env\":{\"B\":\"2\"}},{\"name\":\"metrics\",\"image\":\"exporter:1\"}]}",
+					], { array_strategy = "merge-by-key", indentation_type = "4spaces" })
+				}
+				output "test_merge_by_custom_key" {
+					value = provider::prettyjson::jsondeepmerge([
+						"[{\"id\":1,\"v\":\"a\"},{\"id\":2,\"v\":\"b\"},\"scalar\"]",
+						"[{\"id\":2,\"v\":\"c\"},{\"v\":\"no key\"}]",
+					], { array_strategy = "merge-by-key", merge_key = "id" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_replace", "{\n  \"tags\": [\n    \"b\",\n    \"c\"\n  ]\n}"),
+					resource.TestCheckOutput("test_append", "{\n  \"tags\": [\n    \"a\",\n    \"b\",\n    \"b\",\n    \"c\"\n  ]\n}"),
+					resource.TestCheckOutput("test_unique_append", "{\n  \"tags\": [\n    \"a\",\n    \"b\",\n    \"c\",\n    {\n      \"x\": 1\n    }\n  ]\n}"),
+					resource.TestCheckOutput("test_merge_by_key", "{\n    \"containers\": [\n        {\n            \"name\": \"app\",\n            \"image\": \"app:2\",\n            \"env\": {\n                \"A\": \"1\",\n                \"B\": \"2\"\n            }\n        },\n        {\n            \"name\": \"sidecar\",\n            \"image\": \"proxy:1\"\n        },\n        {\n            \"name\": \"metrics\",\n            \"image\": \"exporter:1\"\n        }\n    ]\n}"),
+					resource.TestCheckOutput("test_merge_by_custom_key", "[\n  {\n    \"id\": 1,\n    \"v\": \"a\"\n  },\n  {\n    \"id\": 2,\n    \"v\": \"c\"\n  },\n  \"scalar\",\n  {\n    \"v\": \"no key\"\n  }\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for the type conflict policy.
+func TestJSONDeepMergeFunction_TypeConflicts(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_override" {
+					value = provider::prettyjson::jsondeepmerge(["{\"a\":{\"b\":1}}", "{\"a\":\"flat\"}"])
+				}
+				output "test_null_is_not_a_conflict" {
+					value = provider::prettyjson::jsondeepmerge(["{\"a\":null}", "{\"a\":{\"b\":1}}"], { on_type_conflict = "error" })
+				}
+				output "test_null_overlay_is_not_a_conflict" {
+					value = provider::prettyjson::jsondeepmerge(["{\"a\":{\"b\":1},\"n\":2}", "{\"a\":null,\"n\":null}"], { on_type_conflict = "error" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_override", "{\n  \"a\": \"flat\"\n}"),
+					resource.TestCheckOutput("test_null_is_not_a_conflict", "{\n  \"a\": {\n    \"b\": 1\n  }\n}"),
+					resource.TestCheckOutput("test_null_overlay_is_not_a_conflict", "{\n  \"a\": null,\n  \"n\": null\n}"),
+				),
+			},
+			{
+				Config: `
+				output "test_error" {
+					value = provider::prettyjson::jsondeepmerge(["{\"spec\":{\"ports\":[{\"name\":\"http\",\"port\":80}]}}", "{}", "{\"spec\":{\"ports\":[{\"name\":\"http\",\"port\":\"80\"}]}}"], { array_strategy = "merge-by-key", on_type_conflict = "error" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`type\s+conflict\s+at\s+path\s+"/spec/ports/0/port":\s+document\s+at\s+index\s+2\s+has\s+string\s+where\s+earlier\s+documents\s+have\s+number`),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONDeepMergeFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_empty_list" {
+					value = provider::prettyjson::jsondeepmerge([])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`At\s+least\s+one\s+JSON\s+document\s+must\s+be\s+provided`),
+			},
+			{
+				Config: `
+				output "test_invalid_document" {
+					value = provider::prettyjson::jsondeepmerge(["{}", "{invalid}"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Document\s+at\s+index\s+1:\s+Invalid\s+JSON\s+syntax\s+detected`),
+			},
+			{
+				Config: `
+				output "test_invalid_strategy" {
+					value = provider::prettyjson::jsondeepmerge(["{}"], { array_strategy = "zip" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+"zip"\s+for\s+option\s+"array_strategy"`),
+			},
+			{
+				Config: `
+				output "test_unknown_option" {
+					value = provider::prettyjson::jsondeepmerge(["{}"], { array_stratgy = "append" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Unknown\s+option\s+"array_stratgy"`),
+			},
+			{
+				Config: `
+				output "test_options_not_object" {
+					value = provider::prettyjson::jsondeepmerge(["{}"], "append")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Options\s+must\s+be\s+an\s+object,\s+got\s+string`),
+			},
+			{
+				Config: `
+				output "test_invalid_indent" {
+					value = provider::prettyjson::jsondeepmerge(["{}"], { indentation_type = "3spaces" })
+				}
+				`,
+				ExpectError: regexp.MustCompile("(?i)invalid.*indentation"),
+			},
+		},
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONDeleteFunction{}
+)
+
+func NewJSONDeleteFunction() function.Function {
+	return JSONDeleteFunction{}
+}
+
+type JSONDeleteFunction struct{}
+
+func (r JSONDeleteFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsondelete")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsondelete"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONDeleteFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsondelete")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Delete a value from a JSON document by JSON Pointer",
+		MarkdownDescription: `Removes the value a JSON Pointer ([RFC 6901](https://www.rfc-editor.org/rfc/rfc6901)) refers to and returns the pretty-printed document.
+
+## Overview
+
+Object members are removed and array elements are removed with the following elements shifted down. Key order and number literals of the rest of the document are preserved. The ` + "`-`" + ` token refers to the last element of an array, so ` + "`\"/items/-\"`" + ` removes the last item.
+
+The pointer must resolve; a missing value is reported together with the deepest prefix of the pointer that did resolve. The whole document (the empty pointer) cannot be deleted.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to modify.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "pointer",
+				MarkdownDescription: "The JSON Pointer of the value to delete.\n\n**Example:** `\"/spec/template/metadata/annotations\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional indentation type or options object.\n\n**Valid Indentation Types:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n\n**Options Object:**\n- `indentation_type` - One of the indentation types above\n\n**Example:**\n`{ indentation_type = \"tab\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONDeleteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsondelete")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON delete function execution")
+
+	var documentString string
+	var pointerString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &pointerString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	indent, funcErr := parseIndentationOptionsArgument(ctx, options, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	pointer, funcErr := parseJSONPointerArgument(ctx, pointerString, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if len(pointer) == 0 {
+		resp.Error = function.NewArgumentFuncError(1, "Cannot delete the whole document, the JSON Pointer must not be empty.")
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	// "-" addresses the last element when deleting, mirroring how it
+	// addresses the append position when setting.
+	if parentPointer, token := pointer.Parent(); token == "-" {
+		if parent, err := parentPointer.Resolve(document); err == nil {
+			if array, ok := parent.([]any); ok && len(array) > 0 {
+				pointer = append(parentPointer[:len(parentPointer):len(parentPointer)], fmt.Sprint(len(array)-1))
+			}
+		}
+	}
+
+	updated, _, err := pointerRemove(document, pointer)
+	if err != nil {
+		tflog.Error(ctx, "JSON delete failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "JSON_DELETE_FAILED",
+			"pointer":    pointerString,
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Cannot delete value: JSON Pointer %s.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, updated, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON delete function execution successful", map[string]any{
+		"result_size": len(result),
+		"pointer":     pointerString,
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for deleting object members and array elements.
+func TestJSONDeleteFunction_Basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_delete_member" {
+					value = provider::prettyjson::jsondelete("{\"zeta\":1,\"debug\":true,\"alpha\":1.50}", "/debug")
+				}
+				output "test_delete_element" {
+					value = provider::prettyjson::jsondelete("{\"items\":[\"a\",\"b\",\"c\"]}", "/items/1")
+				}
+				output "test_delete_last" {
+					value = provider::prettyjson::jsondelete("[1,2,3]", "/-")
+				}
+				output "test_escaped_key" {
+					value = provider::prettyjson::jsondelete("{\"a/b\":1,\"c\":2}", "/a~1b", "4spaces")
+				}
+				output "test_options" {
+					value = provider::prettyjson::jsondelete("{\"a\":1,\"c\":[2]}", "/a", { indentation_type = "tab" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_delete_member", "{\n  \"zeta\": 1,\n  \"alpha\": 1.50\n}"),
+					resource.TestCheckOutput("test_delete_element", "{\n  \"items\": [\n    \"a\",\n    \"c\"\n  ]\n}"),
+					resource.TestCheckOutput("test_delete_last", "[\n  1,\n  2\n]"),
+					resource.TestCheckOutput("test_escaped_key", "{\n    \"c\": 2\n}"),
+					resource.TestCheckOutput("test_options", "{\n\t\"c\": [\n\t\t2\n\t]\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONDeleteFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_missing_member" {
+					value = provider::prettyjson::jsondelete("{\"spec\":{}}", "/spec/debug")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`member\s+"debug"\s+does\s+not\s+exist\s+\(deepest\s+resolved\s+prefix:\s+"/spec"\)`),
+			},
+			{
+				Config: `
+				output "test_empty_array" {
+					value = provider::prettyjson::jsondelete("[]", "/-")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`nonexistent\s+element`),
+			},
+			{
+				Config: `
+				output "test_whole_document" {
+					value = provider::prettyjson::jsondelete("{}", "")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot\s+delete\s+the\s+whole\s+document`),
+			},
+			{
+				Config: `
+				output "test_invalid_pointer" {
+					value = provider::prettyjson::jsondelete("{}", "a")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+Pointer`),
+			},
+			{
+				Config: `
+				output "test_unknown_option" {
+					value = provider::prettyjson::jsondelete("{\"a\":1}", "/a", { indent = "tab" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Unknown\s+option\s+"indent"`),
+			},
+		},
+	})
+}
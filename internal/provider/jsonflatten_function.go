@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONFlattenFunction{}
+)
+
+func NewJSONFlattenFunction() function.Function {
+	return JSONFlattenFunction{}
+}
+
+type JSONFlattenFunction struct{}
+
+func (r JSONFlattenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonflatten")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonflatten"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONFlattenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonflatten")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Flatten a JSON document to a map of paths to string values",
+		MarkdownDescription: `Flattens a JSON document to a ` + "`map(string)`" + ` keyed by paths such as ` + "`a.b[0].c`" + `, the shape expected by SSM Parameter Store hierarchies, Consul KV and environment variables.
+
+## Overview
+
+Object keys are joined with the separator and array indices are written in brackets:
+
+- ` + "`{\"db\":{\"hosts\":[\"a\",\"b\"],\"port\":5432}}`" + ` with separator ` + "`\".\"`" + ` becomes ` + "`{ \"db.hosts[0]\" = \"a\", \"db.hosts[1]\" = \"b\", \"db.port\" = \"5432\" }`" + `
+- With separator ` + "`\"/\"`" + ` the same document becomes ` + "`db/hosts[0]`" + `, ` + "`db/hosts[1]`" + ` and ` + "`db/port`" + `
+
+String values are returned as they are; numbers, booleans and ` + "`null`" + ` as their JSON text. Empty objects and arrays are kept as ` + "`\"{}\"`" + ` and ` + "`\"[]\"`" + `.
+
+## Escaping
+
+Within keys, a backslash escapes every occurrence of the first character of the separator, ` + "`[`" + `, ` + "`]`" + ` and the backslash itself, so ` + "`{\"example.com\":{\"ttl\":60}}`" + ` flattens to ` + "`example\\.com.ttl`" + `. Escaping single characters keeps keys apart that end or start with part of a longer separator: with ` + "`\"__\"`" + `, ` + "`{\"a_\":{\"b\":1}}`" + ` flattens to ` + "`a\\___b`" + ` and ` + "`{\"a\":{\"_b\":1}}`" + ` to ` + "`a__\\_b`" + `. Every document has a single flattened form, and ` + "`jsonunflatten`" + ` reverses it. The one exception is an array under an empty key at the top level, such as ` + "`{\"\":[1]}`" + `: its paths would start with an index like those of a top-level array, so it is rejected.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to flatten. Must be an object or an array.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "separator",
+				MarkdownDescription: "The separator between object keys, for example `\".\"`, `\"/\"` or `\"__\"`. Must not be empty or contain `\\`, `[` or `]`.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "map(string)",
+	})
+}
+
+func (r JSONFlattenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonflatten")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON flatten function execution")
+
+	var documentString string
+	var separator string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &separator))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	if err := validateFlattenSeparator(separator); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid separator: %v.", err))
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	flattened := map[string]string{}
+	err := walkFlattened(document, separator, func(path string, leaf any) error {
+		flattened[path] = flattenedLeafString(leaf)
+		return nil
+	})
+	if err != nil {
+		tflog.Error(ctx, "JSON flatten failed", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "JSON_FLATTEN_FAILED",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot flatten document: %v.", err))
+		return
+	}
+
+	result, diags := types.MapValueFrom(ctx, types.StringType, flattened)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON flatten function execution successful", map[string]any{
+		"entry_count": len(flattened),
+		"separator":   separator,
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for flattened paths and values.
+func TestJSONFlattenFunction_Paths(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"db\":{\"hosts\":[\"a\",\"b\"],\"port\":5432,\"tls\":true,\"password\":null},\"tags\":{},\"list\":[]}"
+				}
+				output "test_dotted" {
+					value = jsonencode(provider::prettyjson::jsonflatten(local.doc, "."))
+				}
+				output "test_slash" {
+					value = provider::prettyjson::jsonflatten(local.doc, "/")["db/hosts[1]"]
+				}
+				output "test_multichar_separator" {
+					value = provider::prettyjson::jsonflatten(local.doc, "__")["db__port"]
+				}
+				output "test_root_array" {
+					value = jsonencode(provider::prettyjson::jsonflatten("[{\"a\":1},[2]]", "."))
+				}
+				output "test_large_number" {
+					value = provider::prettyjson::jsonflatten("{\"n\":12345678901234567890}", ".")["n"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_dotted", `{"db.hosts[0]":"a","db.hosts[1]":"b","db.password":"null","db.port":"5432","db.tls":"true","list":"[]","tags":"{}"}`),
+					resource.TestCheckOutput("test_slash", "b"),
+					resource.TestCheckOutput("test_multichar_separator", "5432"),
+					resource.TestCheckOutput("test_root_array", `{"[0].a":"1","[1][0]":"2"}`),
+					resource.TestCheckOutput("test_large_number", "12345678901234567890"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for escaping of keys that contain the separator.
+func TestJSONFlattenFunction_Escaping(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"example.com\":{\"ttl\":60},\"a[0]\":\"x\",\"back\\\\slash\":\"y\"}"
+				}
+				output "test_escaped" {
+					value = jsonencode(provider::prettyjson::jsonflatten(local.doc, "."))
+				}
+				output "test_round_trip" {
+					value = provider::prettyjson::jsonunflatten(provider::prettyjson::jsonflatten(local.doc, "."), ".", { infer_types = true }) == provider::prettyjson::jsonprettyprint(local.doc)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_escaped", `{"a\\[0\\]":"x","back\\\\slash":"y","example\\.com.ttl":"60"}`),
+					resource.TestCheckOutput("test_round_trip", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for keys that hold part of a multi-character separator.
+func TestJSONFlattenFunction_MultiCharacterSeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"a_\":{\"b\":1},\"a\":{\"_b\":2,\"c__d\":3}}"
+				}
+				output "test_escaped" {
+					value = jsonencode(provider::prettyjson::jsonflatten(local.doc, "__"))
+				}
+				output "test_round_trip" {
+					value = jsondecode(provider::prettyjson::jsonunflatten(provider::prettyjson::jsonflatten(local.doc, "__"), "__", { infer_types = true })) == jsondecode(local.doc)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_escaped", `{"a\\___b":"1","a__\\_b":"2","a__c\\_\\_d":"3"}`),
+					resource.TestCheckOutput("test_round_trip", "true"),
+				),
+			},
+			{
+				Config: `
+				locals {
+					doc = "{\"a→b\":{\"c\":1},\"→\":[2]}"
+				}
+				output "test_escaped" {
+					value = jsonencode(provider::prettyjson::jsonflatten(local.doc, "→"))
+				}
+				output "test_round_trip" {
+					value = jsondecode(provider::prettyjson::jsonunflatten(provider::prettyjson::jsonflatten(local.doc, "→"), "→", { infer_types = true })) == jsondecode(local.doc)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_escaped", `{"\\→[0]":"2","a\\→b→c":"1"}`),
+					resource.TestCheckOutput("test_round_trip", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for empty keys at the top level.
+func TestJSONFlattenFunction_EmptyKey(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"\":{\"a\":1},\"b\":[true]}"
+				}
+				output "test_escaped" {
+					value = jsonencode(provider::prettyjson::jsonflatten(local.doc, "."))
+				}
+				output "test_round_trip" {
+					value = jsondecode(provider::prettyjson::jsonunflatten(provider::prettyjson::jsonflatten(local.doc, "."), ".", { infer_types = true })) == jsondecode(local.doc)
+				}
+				output "test_scalar_round_trip" {
+					value = provider::prettyjson::jsonunflatten(provider::prettyjson::jsonflatten("{\"\":1}", "."), ".", { infer_types = true })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_escaped", `{".a":"1","b[0]":"true"}`),
+					resource.TestCheckOutput("test_round_trip", "true"),
+					resource.TestCheckOutput("test_scalar_round_trip", "{\n  \"\": 1\n}"),
+				),
+			},
+			{
+				Config: `
+				output "test_array" {
+					value = provider::prettyjson::jsonflatten("{\"\":[1],\"a\":2}", ".")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`the\s+empty\s+key\s+at\s+the\s+top\s+level\s+holds\s+an\s+array`),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONFlattenFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_scalar_document" {
+					value = provider::prettyjson::jsonflatten("42", ".")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`only\s+objects\s+and\s+arrays\s+can\s+be\s+flattened,\s+got\s+number`),
+			},
+			{
+				Config: `
+				output "test_empty_separator" {
+					value = provider::prettyjson::jsonflatten("{}", "")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+separator:\s+separator\s+must\s+not\s+be\s+empty`),
+			},
+			{
+				Config: `
+				output "test_bracket_separator" {
+					value = provider::prettyjson::jsonflatten("{}", "[")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`must\s+not\s+contain`),
+			},
+		},
+	})
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONKeyCaseFunction{}
+)
+
+// Key case styles supported by jsonkeycase.
+const (
+	KeyCaseCamel          = "camel"
+	KeyCasePascal         = "pascal"
+	KeyCaseSnake          = "snake"
+	KeyCaseKebab          = "kebab"
+	KeyCaseScreamingSnake = "screaming_snake"
+)
+
+func NewJSONKeyCaseFunction() function.Function {
+	return JSONKeyCaseFunction{}
+}
+
+type JSONKeyCaseFunction struct{}
+
+func (r JSONKeyCaseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonkeycase")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonkeycase"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONKeyCaseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonkeycase")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert the case of all object keys in a JSON document",
+		MarkdownDescription: `Converts every object key in a JSON document to another case style and returns the pretty-printed result, for example to turn snake_case Terraform configuration into the camelCase an API expects.
+
+## Styles
+
+| Style | Example |
+|-------|---------|
+| ` + "`camel`" + ` | ` + "`clientRequestId`" + ` |
+| ` + "`pascal`" + ` | ` + "`ClientRequestId`" + ` |
+| ` + "`snake`" + ` | ` + "`client_request_id`" + ` |
+| ` + "`kebab`" + ` | ` + "`client-request-id`" + ` |
+| ` + "`screaming_snake`" + ` | ` + "`CLIENT_REQUEST_ID`" + ` |
+
+Keys are split into words at ` + "`_`" + `, ` + "`-`" + `, spaces and dots, and at changes of case, so ` + "`HTTPServer`" + ` is split into ` + "`HTTP`" + ` and ` + "`Server`" + `. Leading and trailing underscores and dashes are kept. Values are never changed.
+
+## Acronyms
+
+By default every word is capitalized the same way, so ` + "`user_id`" + ` becomes ` + "`userId`" + `. Words in the ` + "`acronyms`" + ` list are written in upper case by ` + "`camel`" + ` and ` + "`pascal`" + ` instead: with ` + "`acronyms = [\"ID\", \"URL\"]`" + `, ` + "`callback_url`" + ` becomes ` + "`callbackURL`" + `. A leading acronym in ` + "`camel`" + ` style is written in lower case.
+
+## Exclusions
+
+Paths in ` + "`exclude`" + ` are JSON Pointers or glob-style paths, as in ` + "`jsonpick`" + `, matched against the original keys. The keys of an excluded object are left unchanged, so user-defined maps such as ` + "`tags`" + ` keep their keys while the ` + "`tags`" + ` key itself is still converted. Two keys of the same object that convert to the same key are reported as an error.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document whose keys to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "style",
+				MarkdownDescription: "The target case style: `\"camel\"`, `\"pascal\"`, `\"snake\"`, `\"kebab\"` or `\"screaming_snake\"`.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `exclude` - JSON Pointers or glob-style paths of objects whose keys are left unchanged\n- `acronyms` - Words written in upper case by `camel` and `pascal`, such as `[\"ID\", \"URL\"]`\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ exclude = [\"**.tags\"], acronyms = [\"ID\", \"URL\"] }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONKeyCaseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonkeycase")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON key case function execution")
+
+	var documentString string
+	var style string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &style, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	switch style {
+	case KeyCaseCamel, KeyCasePascal, KeyCaseSnake, KeyCaseKebab, KeyCaseScreamingSnake:
+	default:
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+			"Invalid style %q. Valid styles are: %s, %s, %s, %s, %s.", style,
+			KeyCaseCamel, KeyCasePascal, KeyCaseSnake, KeyCaseKebab, KeyCaseScreamingSnake))
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 2, "exclude", "acronyms", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	exclude, funcErr := opts.stringListOption("exclude")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	excludePatterns, err := parseJSONPathPatterns(exclude)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid exclude %v.", err))
+		return
+	}
+
+	acronyms, funcErr := opts.stringListOption("acronyms")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	converter := newKeyCaseConverter(style, acronyms, excludePatterns)
+	converted, err := converter.convert(document, jsonPointer{})
+	if err != nil {
+		tflog.Error(ctx, "JSON key case conversion failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "KEY_CASE_COLLISION",
+			"style":      style,
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewFuncError(fmt.Sprintf("Key case conversion failed: %v.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, converted, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON key case function execution successful", map[string]any{
+		"result_size": len(result),
+		"style":       style,
+	})
+}
+
+// keyCaseConverter converts object keys to a case style.
+type keyCaseConverter struct {
+	style    string
+	acronyms map[string]string
+	exclude  jsonPathPatterns
+}
+
+func newKeyCaseConverter(style string, acronyms []string, exclude jsonPathPatterns) keyCaseConverter {
+	converter := keyCaseConverter{style: style, acronyms: map[string]string{}, exclude: exclude}
+	for _, acronym := range acronyms {
+		converter.acronyms[strings.ToLower(acronym)] = strings.ToUpper(acronym)
+	}
+	return converter
+}
+
+// convert converts the keys of value and everything below it, except for the
+// keys of objects at excluded locations.
+func (c keyCaseConverter) convert(value any, location jsonPointer) (any, error) {
+	switch v := value.(type) {
+	case *jsonObject:
+		excluded := c.exclude.Matches(location)
+		converted := newJSONObject()
+		sources := map[string]string{}
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			member, err := c.convert(member, location.child(key))
+			if err != nil {
+				return nil, err
+			}
+			newKey := key
+			if !excluded {
+				newKey = c.convertKey(key)
+			}
+			if source, exists := sources[newKey]; exists {
+				return nil, fmt.Errorf("keys %q and %q at %q both convert to %q", source, key, location.String(), newKey)
+			}
+			sources[newKey] = key
+			converted.Set(newKey, member)
+		}
+		return converted, nil
+	case []any:
+		converted := make([]any, len(v))
+		for i, element := range v {
+			element, err := c.convert(element, location.child(strconv.Itoa(i)))
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = element
+		}
+		return converted, nil
+	default:
+		return value, nil
+	}
+}
+
+// convertKey converts a single key. Leading and trailing underscores and
+// dashes are kept as they are.
+func (c keyCaseConverter) convertKey(key string) string {
+	trimmed := strings.Trim(key, "_-")
+	if trimmed == "" {
+		return key
+	}
+	start := strings.Index(key, trimmed)
+	prefix, suffix := key[:start], key[start+len(trimmed):]
+
+	words := splitKeyWords(trimmed)
+	if len(words) == 0 {
+		return key
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	for i, word := range words {
+		lower := strings.ToLower(word)
+		switch c.style {
+		case KeyCaseCamel, KeyCasePascal:
+			acronym, isAcronym := c.acronyms[lower]
+			switch {
+			case i == 0 && c.style == KeyCaseCamel:
+				b.WriteString(lower)
+			case isAcronym:
+				b.WriteString(acronym)
+			default:
+				b.WriteString(capitalize(lower))
+			}
+		case KeyCaseSnake:
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteString(lower)
+		case KeyCaseKebab:
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteString(lower)
+		case KeyCaseScreamingSnake:
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteString(strings.ToUpper(word))
+		}
+	}
+	b.WriteString(suffix)
+	return b.String()
+}
+
+// splitKeyWords splits a key into words at separators and case changes.
+// A run of upper case letters followed by a lower case letter ends before
+// the last upper case letter, so "HTTPServer" splits into "HTTP" and
+// "Server". Digits stay with the preceding word.
+func splitKeyWords(key string) []string {
+	var words []string
+	var current []rune
+	runes := []rune(key)
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = nil
+		}
+	}
+	for i, r := range runes {
+		if r == '_' || r == '-' || r == ' ' || r == '.' {
+			flush()
+			continue
+		}
+		if i > 0 && unicode.IsUpper(r) && len(current) > 0 {
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+	return words
+}
+
+// capitalize returns s with its first letter in upper case.
+func capitalize(s string) string {
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
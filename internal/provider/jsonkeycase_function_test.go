@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for case styles.
+func TestJSONKeyCaseFunction_Styles(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"client_request_id\":\"a\",\"HTTPServer\":{\"max-conns\":10,\"sha256Sum\":\"x\"},\"_internal\":true,\"items\":[{\"item_name\":\"b\"}]}"
+				}
+				output "test_camel" {
+					value = provider::prettyjson::jsonkeycase(local.doc, "camel")
+				}
+				output "test_pascal" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonkeycase(local.doc, "pascal")))
+				}
+				output "test_snake" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonkeycase(local.doc, "snake")))
+				}
+				output "test_kebab" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonkeycase(local.doc, "kebab")))
+				}
+				output "test_screaming_snake" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonkeycase(local.doc, "screaming_snake")))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_camel", "{\n  \"clientRequestId\": \"a\",\n  \"httpServer\": {\n    \"maxConns\": 10,\n    \"sha256Sum\": \"x\"\n  },\n  \"_internal\": true,\n  \"items\": [\n    {\n      \"itemName\": \"b\"\n    }\n  ]\n}"),
+					resource.TestCheckOutput("test_pascal", `{"ClientRequestId":"a","HttpServer":{"MaxConns":10,"Sha256Sum":"x"},"Items":[{"ItemName":"b"}],"_Internal":true}`),
+					resource.TestCheckOutput("test_snake", `{"_internal":true,"client_request_id":"a","http_server":{"max_conns":10,"sha256_sum":"x"},"items":[{"item_name":"b"}]}`),
+					resource.TestCheckOutput("test_kebab", `{"_internal":true,"client-request-id":"a","http-server":{"max-conns":10,"sha256-sum":"x"},"items":[{"item-name":"b"}]}`),
+					resource.TestCheckOutput("test_screaming_snake", `{"CLIENT_REQUEST_ID":"a","HTTP_SERVER":{"MAX_CONNS":10,"SHA256_SUM":"x"},"ITEMS":[{"ITEM_NAME":"b"}],"_INTERNAL":true}`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for acronyms and exclusions.
+func TestJSONKeyCaseFunction_AcronymsAndExclusions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"user_id\":1,\"callback_url\":\"https://example.com\",\"id_token\":\"t\",\"resource_tags\":{\"cost_center\":\"42\",\"team-name\":\"platform\"},\"nested\":{\"tags\":{\"owner_email\":\"a@example.com\"}}}"
+				}
+				output "test_acronyms_camel" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonkeycase(local.doc, "camel", { acronyms = ["ID", "URL"], exclude = ["resource_tags", "**.tags"] })))
+				}
+				output "test_acronyms_pascal" {
+					value = jsonencode(keys(jsondecode(provider::prettyjson::jsonkeycase(local.doc, "pascal", { acronyms = ["id", "url"] }))))
+				}
+				output "test_pointer_exclusion" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonkeycase(local.doc, "kebab", { exclude = ["/nested/tags"] })).nested)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_acronyms_camel", `{"callbackURL":"https://example.com","idToken":"t","nested":{"tags":{"owner_email":"a@example.com"}},"resourceTags":{"cost_center":"42","team-name":"platform"},"userID":1}`),
+					resource.TestCheckOutput("test_acronyms_pascal", `["CallbackURL","IDToken","Nested","ResourceTags","UserID"]`),
+					resource.TestCheckOutput("test_pointer_exclusion", `{"tags":{"owner_email":"a@example.com"}}`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONKeyCaseFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_collision" {
+					value = provider::prettyjson::jsonkeycase("{\"a\":{\"user_id\":1,\"userId\":2}}", "snake")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`keys\s+"user_id"\s+and\s+"userId"\s+at\s+"/a"\s+both\s+convert\s+to\s+"user_id"`),
+			},
+			{
+				Config: `
+				output "test_invalid_style" {
+					value = provider::prettyjson::jsonkeycase("{}", "title")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+style\s+"title"`),
+			},
+			{
+				Config: `
+				output "test_invalid_exclude" {
+					value = provider::prettyjson::jsonkeycase("{}", "camel", { exclude = "tags" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Option\s+"exclude"\s+must\s+be\s+a\s+list\s+of\s+strings`),
+			},
+		},
+	})
+}
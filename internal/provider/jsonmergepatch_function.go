@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONMergePatchFunction{}
+)
+
+func NewJSONMergePatchFunction() function.Function {
+	return JSONMergePatchFunction{}
+}
+
+type JSONMergePatchFunction struct{}
+
+func (r JSONMergePatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonmergepatch")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonmergepatch"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONMergePatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonmergepatch")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Apply an RFC 7396 JSON Merge Patch to a JSON document",
+		MarkdownDescription: `Applies a JSON Merge Patch ([RFC 7396](https://www.rfc-editor.org/rfc/rfc7396)) to a target JSON document and returns the pretty-printed result.
+
+## Overview
+
+Unlike Terraform's built-in ` + "`merge()`" + `, a merge patch is applied recursively and can delete keys:
+
+- **Objects merge recursively**: members of the patch are merged into the matching members of the target
+- **null removes a key**: a patch member with a ` + "`null`" + ` value deletes that member from the target
+- **Everything else replaces**: arrays, strings, numbers and booleans in the patch replace the target value outright
+- **Non-object patches replace the whole target**
+
+Existing keys keep their position in the target and new keys are appended in patch order. The result is formatted with the same indentation options as ` + "`jsonprettyprint`" + `.
+
+## Input Validation
+
+Both arguments go through the same validation as ` + "`jsonprettyprint`" + `: empty input, the 10MB size limit and JSON syntax are checked, and failures are reported as validation or parsing errors against the offending argument.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "target",
+				MarkdownDescription: "The JSON document to apply the merge patch to.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "patch",
+				MarkdownDescription: "The JSON Merge Patch document. Use `null` values to remove keys from the target.\n\n**Example:**\n`{\"replicas\":3,\"debug\":null,\"resources\":{\"limits\":{\"cpu\":\"2\"}}}`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional indentation type or options object.\n\n**Valid Indentation Types:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n\n**Options Object:**\n- `indentation_type` - One of the indentation types above\n\n**Example:**\n`{ indentation_type = \"tab\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONMergePatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonmergepatch")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON merge patch function execution")
+
+	var targetString string
+	var patchString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &targetString, &patchString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	indent, funcErr := parseIndentationOptionsArgument(ctx, options, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	target, funcErr := parseJSONArgument(ctx, targetString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	patch, funcErr := parseJSONArgument(ctx, patchString, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	merged := applyJSONMergePatch(target, patch)
+
+	result, funcErr := formatJSONResult(ctx, merged, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON merge patch function execution successful", map[string]any{
+		"result_size": len(result),
+		"target_size": len(targetString),
+		"patch_size":  len(patchString),
+	})
+}
+
+// applyJSONMergePatch implements the MergePatch algorithm from RFC 7396,
+// section 2. The target is modified in place where possible; callers pass a
+// freshly parsed document, so no copy is needed.
+func applyJSONMergePatch(target, patch any) any {
+	patchObject, ok := patch.(*jsonObject)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(*jsonObject)
+	if !ok {
+		targetObject = newJSONObject()
+	}
+
+	for _, name := range patchObject.Keys() {
+		value, _ := patchObject.Get(name)
+		if value == nil {
+			targetObject.Delete(name)
+			continue
+		}
+		current, _ := targetObject.Get(name)
+		targetObject.Set(name, applyJSONMergePatch(current, value))
+	}
+
+	return targetObject
+}
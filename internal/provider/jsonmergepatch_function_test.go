@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test covering the examples from RFC 7396 Appendix A.
+func TestJSONMergePatchFunction_RFCExamples(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_replace_member" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":\"b\"}", "{\"a\":\"c\"}")
+				}
+				output "test_add_member" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":\"b\"}", "{\"b\":\"c\"}")
+				}
+				output "test_remove_member" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":\"b\",\"b\":\"c\"}", "{\"a\":null}")
+				}
+				output "test_array_replaces" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":[\"b\"]}", "{\"a\":\"c\"}")
+				}
+				output "test_nested_merge" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":{\"b\":\"c\"}}", "{\"a\":{\"b\":\"d\",\"c\":null}}")
+				}
+				output "test_array_of_objects" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":[{\"b\":\"c\"}]}", "{\"a\":[1]}")
+				}
+				output "test_non_object_patch" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":\"foo\"}", "[\"c\"]")
+				}
+				output "test_null_patch" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":\"foo\"}", "null")
+				}
+				output "test_non_object_target" {
+					value = provider::prettyjson::jsonmergepatch("[1,2]", "{\"a\":\"b\",\"c\":null}")
+				}
+				output "test_nulls_in_new_member" {
+					value = provider::prettyjson::jsonmergepatch("{}", "{\"a\":{\"bb\":{\"ccc\":null}}}")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_replace_member", "{\n  \"a\": \"c\"\n}"),
+					resource.TestCheckOutput("test_add_member", "{\n  \"a\": \"b\",\n  \"b\": \"c\"\n}"),
+					resource.TestCheckOutput("test_remove_member", "{\n  \"b\": \"c\"\n}"),
+					resource.TestCheckOutput("test_array_replaces", "{\n  \"a\": \"c\"\n}"),
+					resource.TestCheckOutput("test_nested_merge", "{\n  \"a\": {\n    \"b\": \"d\"\n  }\n}"),
+					resource.TestCheckOutput("test_array_of_objects", "{\n  \"a\": [\n    1\n  ]\n}"),
+					resource.TestCheckOutput("test_non_object_patch", "[\n  \"c\"\n]"),
+					resource.TestCheckOutput("test_null_patch", "null"),
+					resource.TestCheckOutput("test_non_object_target", "{\n  \"a\": \"b\"\n}"),
+					resource.TestCheckOutput("test_nulls_in_new_member", "{\n  \"a\": {\n    \"bb\": {}\n  }\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for config layering, key order and indentation.
+func TestJSONMergePatchFunction_Layering(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_layering" {
+					value = provider::prettyjson::jsonmergepatch("{\"service\":\"api\",\"replicas\":1,\"debug\":true,\"resources\":{\"limits\":{\"cpu\":\"1\",\"memory\":\"1Gi\"}}}", "{\"replicas\":3,\"debug\":null,\"resources\":{\"limits\":{\"cpu\":\"2\"}},\"region\":\"eu-west-1\"}")
+				}
+				output "test_4spaces" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":{\"b\":1}}", "{\"a\":{\"c\":2}}", "4spaces")
+				}
+				output "test_tab" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":1}", "{}", "tab")
+				}
+				output "test_options" {
+					value = provider::prettyjson::jsonmergepatch("{\"a\":1}", "{}", { indentation_type = "tab" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_layering", "{\n  \"service\": \"api\",\n  \"replicas\": 3,\n  \"resources\": {\n    \"limits\": {\n      \"cpu\": \"2\",\n      \"memory\": \"1Gi\"\n    }\n  },\n  \"region\": \"eu-west-1\"\n}"),
+					resource.TestCheckOutput("test_4spaces", "{\n    \"a\": {\n        \"b\": 1,\n        \"c\": 2\n    }\n}"),
+					resource.TestCheckOutput("test_tab", "{\n\t\"a\": 1\n}"),
+					resource.TestCheckOutput("test_options", "{\n\t\"a\": 1\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONMergePatchFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_target" {
+					value = provider::prettyjson::jsonmergepatch("{invalid}", "{}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"target"\s+parameter:\s+Invalid\s+JSON\s+syntax\s+detected`),
+			},
+			{
+				Config: `
+				output "test_invalid_patch" {
+					value = provider::prettyjson::jsonmergepatch("{}", "{\"a\":1,}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"patch"\s+parameter:\s+Invalid\s+JSON\s+syntax\s+detected`),
+			},
+			{
+				Config: `
+				output "test_empty_target" {
+					value = provider::prettyjson::jsonmergepatch("", "{}")
+				}
+				`,
+				ExpectError: regexp.MustCompile("JSON input cannot be empty"),
+			},
+			{
+				Config: `
+				output "test_invalid_indent" {
+					value = provider::prettyjson::jsonmergepatch("{}", "{}", "mixed")
+				}
+				`,
+				ExpectError: regexp.MustCompile("(?i)invalid.*indentation"),
+			},
+		},
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONOmitFunction{}
+)
+
+func NewJSONOmitFunction() function.Function {
+	return JSONOmitFunction{}
+}
+
+type JSONOmitFunction struct{}
+
+func (r JSONOmitFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonomit")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonomit"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONOmitFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonomit")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Remove selected paths from a JSON document",
+		MarkdownDescription: `Returns a JSON document with the values at the given paths removed, for example to strip ` + "`status`" + `, ` + "`metadata.managedFields`" + ` and timestamps from exported resources before committing them.
+
+## Paths
+
+Each path is a JSON Pointer ([RFC 6901](https://www.rfc-editor.org/rfc/rfc6901)) or a glob-style path, as in ` + "`jsonpick`" + `:
+
+- ` + "`\"/metadata/uid\"`" + ` or ` + "`\"metadata.uid\"`" + ` - a single member
+- ` + "`\"spec.containers[*].resources\"`" + ` - ` + "`*`" + ` matches any key or index, and can be combined with text as in ` + "`\"*Timestamp\"`" + `
+- ` + "`\"**.creationTimestamp\"`" + ` - ` + "`**`" + ` matches any number of levels
+
+A backslash escapes ` + "`.`" + `, ` + "`[`" + `, ` + "`]`" + `, ` + "`*`" + `, ` + "`?`" + ` and itself within glob keys. Paths that match nothing are ignored.
+
+## Result
+
+Key order and number literals of the remaining document are preserved. Removed array elements are taken out and the following elements shift down. The whole document cannot be omitted.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to reduce.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.ListParameter{
+				Name:                "paths",
+				ElementType:         types.StringType,
+				MarkdownDescription: "JSON Pointers or glob-style paths of the values to remove.\n\n**Example:** `[\"status\", \"metadata.managedFields\", \"**.creationTimestamp\"]`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ indentation_type = \"4spaces\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONOmitFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonomit")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON omit function execution")
+
+	var documentString string
+	var paths []string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &paths, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 2, "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	patterns, funcErr := parseJSONPathPatternsArgument(ctx, paths, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if patterns.Matches(jsonPointer{}) {
+		resp.Error = function.NewArgumentFuncError(1, "Cannot omit the whole document, paths must not match the document root.")
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, omitJSONValue(document, jsonPointer{}, patterns), indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON omit function execution successful", map[string]any{
+		"result_size": len(result),
+		"path_count":  len(paths),
+	})
+}
+
+// omitJSONValue returns value without the members and elements below
+// location that are matched by the patterns.
+func omitJSONValue(value any, location jsonPointer, patterns jsonPathPatterns) any {
+	if !patterns.MayMatchBelow(location) {
+		return value
+	}
+
+	switch v := value.(type) {
+	case *jsonObject:
+		kept := newJSONObject()
+		for _, key := range v.Keys() {
+			memberLocation := location.child(key)
+			if patterns.Matches(memberLocation) {
+				continue
+			}
+			member, _ := v.Get(key)
+			kept.Set(key, omitJSONValue(member, memberLocation, patterns))
+		}
+		return kept
+	case []any:
+		kept := []any{}
+		for i, element := range v {
+			elementLocation := location.child(strconv.Itoa(i))
+			if patterns.Matches(elementLocation) {
+				continue
+			}
+			kept = append(kept, omitJSONValue(element, elementLocation, patterns))
+		}
+		return kept
+	default:
+		return value
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for omitting with JSON Pointers and globs.
+func TestJSONOmitFunction_Paths(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					resource = "{\"kind\":\"Deployment\",\"metadata\":{\"name\":\"api\",\"uid\":\"123\",\"creationTimestamp\":\"2024-01-01T00:00:00Z\",\"managedFields\":[{\"manager\":\"kubectl\"}]},\"spec\":{\"replicas\":3,\"template\":{\"metadata\":{\"creationTimestamp\":null}}},\"status\":{\"readyReplicas\":3}}"
+				}
+				output "test_export_cleanup" {
+					value = provider::prettyjson::jsonomit(local.resource, ["status", "metadata.managedFields", "/metadata/uid", "**.creationTimestamp"])
+				}
+				output "test_array_elements" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonomit("{\"items\":[{\"id\":1,\"tmp\":true},{\"id\":2},{\"id\":3}]}", ["items[1]", "items[*].tmp"])))
+				}
+				output "test_key_wildcard" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonomit("{\"createdAt\":1,\"updatedAt\":2,\"name\":\"x\"}", ["*At"])))
+				}
+				output "test_escaped_non_ascii" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonomit("{\"éa\":1,\"eb\":2}", ["\\é*"])))
+				}
+				output "test_no_match" {
+					value = provider::prettyjson::jsonomit("{\"a\":1}", ["b"], { indentation_type = "tab" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_export_cleanup", "{\n  \"kind\": \"Deployment\",\n  \"metadata\": {\n    \"name\": \"api\"\n  },\n  \"spec\": {\n    \"replicas\": 3,\n    \"template\": {\n      \"metadata\": {}\n    }\n  }\n}"),
+					resource.TestCheckOutput("test_array_elements", `{"items":[{"id":1},{"id":3}]}`),
+					resource.TestCheckOutput("test_key_wildcard", `{"name":"x"}`),
+					resource.TestCheckOutput("test_escaped_non_ascii", `{"eb":2}`),
+					resource.TestCheckOutput("test_no_match", "{\n\t\"a\": 1\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONOmitFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_omit_root" {
+					value = provider::prettyjson::jsonomit("{\"a\":1}", ["**"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Cannot\s+omit\s+the\s+whole\s+document`),
+			},
+			{
+				Config: `
+				output "test_trailing_dot" {
+					value = provider::prettyjson::jsonomit("{}", ["metadata."])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`glob\s+"metadata\."\s+must\s+not\s+end\s+with\s+'\.'`),
+			},
+		},
+	})
+}
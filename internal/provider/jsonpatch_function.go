@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONPatchFunction{}
+)
+
+func NewJSONPatchFunction() function.Function {
+	return JSONPatchFunction{}
+}
+
+type JSONPatchFunction struct{}
+
+func (r JSONPatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpatch")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonpatch"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONPatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpatch")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Apply an RFC 6902 JSON Patch to a JSON document",
+		MarkdownDescription: `Applies a JSON Patch ([RFC 6902](https://www.rfc-editor.org/rfc/rfc6902)) to a JSON document and returns the pretty-printed result.
+
+## Overview
+
+The patch is a JSON array of operations that are applied in order. If any operation fails, the whole patch is rejected and no partial result is returned. The result is formatted with the same indentation options as ` + "`jsonprettyprint`" + `, while object keys keep the order they have in the input document.
+
+## Supported Operations
+
+- **add**: Add a member to an object, insert an element into an array (` + "`-`" + ` appends), or replace the whole document
+- **remove**: Remove an existing member or element
+- **replace**: Replace an existing value
+- **move**: Remove the value at ` + "`from`" + ` and add it at ` + "`path`" + `
+- **copy**: Add a copy of the value at ` + "`from`" + ` at ` + "`path`" + `
+- **test**: Check that the value at ` + "`path`" + ` equals ` + "`value`" + `
+
+## Error Handling
+
+Errors name the zero-based index of the failing operation and its path:
+- Invalid document or patch JSON
+- Malformed operations (missing ` + "`op`" + `, ` + "`path`" + `, ` + "`from`" + ` or ` + "`value`" + `)
+- Pointers that do not resolve against the document
+- Failed ` + "`test`" + ` operations`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to patch.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "patch",
+				MarkdownDescription: "The JSON Patch to apply, as a JSON array of operation objects.\n\n**Example:**\n`[{\"op\":\"replace\",\"path\":\"/replicas\",\"value\":3},{\"op\":\"remove\",\"path\":\"/debug\"}]`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional indentation type or options object.\n\n**Valid Indentation Types:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n\n**Options Object:**\n- `indentation_type` - One of the indentation types above\n\n**Example:**\n`{ indentation_type = \"tab\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONPatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpatch")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON patch function execution")
+
+	var documentString string
+	var patchString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &patchString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	indent, funcErr := parseIndentationOptionsArgument(ctx, options, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	patchDocument, funcErr := parseJSONArgument(ctx, patchString, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	operations, err := parseJSONPatch(patchDocument)
+	if err != nil {
+		tflog.Error(ctx, "Invalid JSON Patch", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "INVALID_JSON_PATCH",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Applying JSON Patch", map[string]any{
+		"operation_count": len(operations),
+	})
+
+	patched, err := applyJSONPatch(document, operations)
+	if err != nil {
+		tflog.Error(ctx, "JSON Patch application failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "JSON_PATCH_FAILED",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, patched, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON patch function execution successful", map[string]any{
+		"result_size":     len(result),
+		"operation_count": len(operations),
+	})
+}
+
+// jsonPatchOperation is a single decoded RFC 6902 operation.
+type jsonPatchOperation struct {
+	Op    string
+	Path  jsonPointer
+	From  jsonPointer
+	Value any
+}
+
+// jsonPatchError reports a failure while applying a patch, identifying the
+// operation by its zero-based index within the patch array.
+type jsonPatchError struct {
+	Index  int
+	Op     string
+	Path   string
+	Reason string
+}
+
+func (e *jsonPatchError) Error() string {
+	return fmt.Sprintf("JSON Patch operation at index %d (%s) failed at path %q: %s", e.Index, e.Op, e.Path, e.Reason)
+}
+
+// parseJSONPatch validates the structure of a decoded patch document.
+func parseJSONPatch(patch any) ([]jsonPatchOperation, error) {
+	entries, ok := patch.([]any)
+	if !ok {
+		return nil, fmt.Errorf("JSON Patch must be an array of operations, got %s", jsonTypeName(patch))
+	}
+
+	operations := make([]jsonPatchOperation, 0, len(entries))
+	for index, entry := range entries {
+		object, ok := entry.(*jsonObject)
+		if !ok {
+			return nil, fmt.Errorf("JSON Patch operation at index %d must be an object, got %s", index, jsonTypeName(entry))
+		}
+
+		op, err := patchStringMember(object, "op", index)
+		if err != nil {
+			return nil, err
+		}
+		rawPath, err := patchStringMember(object, "path", index)
+		if err != nil {
+			return nil, err
+		}
+		path, err := parseJSONPointer(rawPath)
+		if err != nil {
+			return nil, fmt.Errorf("JSON Patch operation at index %d (%s) has an invalid path: %w", index, op, err)
+		}
+
+		operation := jsonPatchOperation{Op: op, Path: path}
+		switch op {
+		case "add", "replace", "test":
+			value, ok := object.Get("value")
+			if !ok {
+				return nil, fmt.Errorf("JSON Patch operation at index %d (%s) at path %q is missing the required \"value\" member", index, op, rawPath)
+			}
+			operation.Value = value
+		case "move", "copy":
+			rawFrom, err := patchStringMember(object, "from", index)
+			if err != nil {
+				return nil, err
+			}
+			operation.From, err = parseJSONPointer(rawFrom)
+			if err != nil {
+				return nil, fmt.Errorf("JSON Patch operation at index %d (%s) has an invalid from: %w", index, op, err)
+			}
+		case "remove":
+		default:
+			return nil, fmt.Errorf("JSON Patch operation at index %d at path %q has unsupported op %q. "+
+				"Valid operations are: add, remove, replace, move, copy, test", index, rawPath, op)
+		}
+
+		operations = append(operations, operation)
+	}
+
+	return operations, nil
+}
+
+func patchStringMember(object *jsonObject, name string, index int) (string, error) {
+	value, ok := object.Get(name)
+	if !ok {
+		return "", fmt.Errorf("JSON Patch operation at index %d is missing the required %q member", index, name)
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("JSON Patch operation at index %d has a non-string %q member", index, name)
+	}
+	return s, nil
+}
+
+// applyJSONPatch applies operations to a copy of document. The input document
+// is never modified, so a failed patch leaves no partial changes behind.
+func applyJSONPatch(document any, operations []jsonPatchOperation) (any, error) {
+	result := cloneJSONValue(document)
+
+	for index, operation := range operations {
+		var err error
+		result, err = applyJSONPatchOperation(result, operation)
+		if err != nil {
+			reason := err.Error()
+			if pointerErr, ok := err.(*jsonPointerError); ok {
+				reason = fmt.Sprintf("%s (deepest resolved prefix: %q)", pointerErr.Reason, pointerErr.Resolved.String())
+			}
+			return nil, &jsonPatchError{Index: index, Op: operation.Op, Path: operation.Path.String(), Reason: reason}
+		}
+	}
+
+	return result, nil
+}
+
+func applyJSONPatchOperation(document any, operation jsonPatchOperation) (any, error) {
+	switch operation.Op {
+	case "add":
+		return pointerAdd(document, operation.Path, cloneJSONValue(operation.Value))
+	case "remove":
+		result, _, err := pointerRemove(document, operation.Path)
+		return result, err
+	case "replace":
+		return pointerReplace(document, operation.Path, cloneJSONValue(operation.Value))
+	case "move":
+		if operation.From.String() == operation.Path.String() {
+			if _, err := operation.From.Resolve(document); err != nil {
+				return nil, fmt.Errorf("from %q: %w", operation.From.String(), err)
+			}
+			return document, nil
+		}
+		if operation.From.IsPrefixOf(operation.Path) {
+			return nil, fmt.Errorf("cannot move %q into one of its own children", operation.From.String())
+		}
+		result, value, err := pointerRemove(document, operation.From)
+		if err != nil {
+			return nil, fmt.Errorf("from %q: %w", operation.From.String(), err)
+		}
+		return pointerAdd(result, operation.Path, value)
+	case "copy":
+		value, err := operation.From.Resolve(document)
+		if err != nil {
+			return nil, fmt.Errorf("from %q: %w", operation.From.String(), err)
+		}
+		return pointerAdd(document, operation.Path, cloneJSONValue(value))
+	case "test":
+		actual, err := operation.Path.Resolve(document)
+		if err != nil {
+			return nil, err
+		}
+		if !jsonValuesEqual(actual, operation.Value) {
+			return nil, fmt.Errorf("test failed: expected %s, found %s",
+				compactJSONPreview(operation.Value), compactJSONPreview(actual))
+		}
+		return document, nil
+	default:
+		return nil, fmt.Errorf("unsupported op %q", operation.Op)
+	}
+}
+
+// compactJSONPreview renders a value as compact JSON for error messages.
+func compactJSONPreview(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return jsonTypeName(value)
+	}
+	return truncateString(string(encoded), 100)
+}
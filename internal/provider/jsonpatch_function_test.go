@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for each RFC 6902 operation.
+func TestJSONPatchFunction_Operations(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_add" {
+					value = provider::prettyjson::jsonpatch("{\"b\":1,\"a\":2}", "[{\"op\":\"add\",\"path\":\"/c\",\"value\":{\"d\":true}}]")
+				}
+				output "test_add_array" {
+					value = provider::prettyjson::jsonpatch("{\"list\":[1,3]}", "[{\"op\":\"add\",\"path\":\"/list/1\",\"value\":2},{\"op\":\"add\",\"path\":\"/list/-\",\"value\":4}]")
+				}
+				output "test_remove" {
+					value = provider::prettyjson::jsonpatch("{\"keep\":1,\"drop\":2,\"list\":[1,2,3]}", "[{\"op\":\"remove\",\"path\":\"/drop\"},{\"op\":\"remove\",\"path\":\"/list/0\"}]")
+				}
+				output "test_replace" {
+					value = provider::prettyjson::jsonpatch("{\"replicas\":1,\"image\":\"app:1.0\"}", "[{\"op\":\"replace\",\"path\":\"/replicas\",\"value\":3}]")
+				}
+				output "test_move" {
+					value = provider::prettyjson::jsonpatch("{\"old\":{\"x\":1},\"new\":{}}", "[{\"op\":\"move\",\"from\":\"/old/x\",\"path\":\"/new/x\"}]")
+				}
+				output "test_copy" {
+					value = provider::prettyjson::jsonpatch("{\"base\":[1,2]}", "[{\"op\":\"copy\",\"from\":\"/base\",\"path\":\"/clone\"}]")
+				}
+				output "test_test" {
+					value = provider::prettyjson::jsonpatch("{\"version\":1.0}", "[{\"op\":\"test\",\"path\":\"/version\",\"value\":1},{\"op\":\"replace\",\"path\":\"/version\",\"value\":2}]")
+				}
+				output "test_escaped_pointer" {
+					value = provider::prettyjson::jsonpatch("{\"a/b\":1,\"m~n\":2}", "[{\"op\":\"replace\",\"path\":\"/a~1b\",\"value\":10},{\"op\":\"remove\",\"path\":\"/m~0n\"}]")
+				}
+				output "test_whole_document" {
+					value = provider::prettyjson::jsonpatch("{\"a\":1}", "[{\"op\":\"replace\",\"path\":\"\",\"value\":[1]}]")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_add", "{\n  \"b\": 1,\n  \"a\": 2,\n  \"c\": {\n    \"d\": true\n  }\n}"),
+					resource.TestCheckOutput("test_add_array", "{\n  \"list\": [\n    1,\n    2,\n    3,\n    4\n  ]\n}"),
+					resource.TestCheckOutput("test_remove", "{\n  \"keep\": 1,\n  \"list\": [\n    2,\n    3\n  ]\n}"),
+					resource.TestCheckOutput("test_replace", "{\n  \"replicas\": 3,\n  \"image\": \"app:1.0\"\n}"),
+					resource.TestCheckOutput("test_move", "{\n  \"old\": {},\n  \"new\": {\n    \"x\": 1\n  }\n}"),
+					resource.TestCheckOutput("test_copy", "{\n  \"base\": [\n    1,\n    2\n  ],\n  \"clone\": [\n    1,\n    2\n  ]\n}"),
+					resource.TestCheckOutput("test_test", "{\n  \"version\": 2\n}"),
+					resource.TestCheckOutput("test_escaped_pointer", "{\n  \"a/b\": 10\n}"),
+					resource.TestCheckOutput("test_whole_document", "[\n  1\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for indentation options and number preservation.
+func TestJSONPatchFunction_Formatting(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_4spaces" {
+					value = provider::prettyjson::jsonpatch("{\"a\":{\"b\":1}}", "[]", "4spaces")
+				}
+				output "test_tab" {
+					value = provider::prettyjson::jsonpatch("{\"a\":{\"b\":1}}", "[]", "tab")
+				}
+				output "test_options" {
+					value = provider::prettyjson::jsonpatch("{\"a\":{\"b\":1}}", "[]", { indentation_type = "tab" })
+				}
+				output "test_big_numbers" {
+					value = provider::prettyjson::jsonpatch("{\"id\":12345678901234567890,\"ratio\":1.50}", "[{\"op\":\"add\",\"path\":\"/n\",\"value\":1e3}]")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_4spaces", "{\n    \"a\": {\n        \"b\": 1\n    }\n}"),
+					resource.TestCheckOutput("test_tab", "{\n\t\"a\": {\n\t\t\"b\": 1\n\t}\n}"),
+					resource.TestCheckOutput("test_options", "{\n\t\"a\": {\n\t\t\"b\": 1\n\t}\n}"),
+					resource.TestCheckOutput("test_big_numbers", "{\n  \"id\": 12345678901234567890,\n  \"ratio\": 1.50,\n  \"n\": 1e3\n}"),
+				),
+			},
+			{
+				Config: `
+				output "test_invalid_indent" {
+					value = provider::prettyjson::jsonpatch("{}", "[]", "3spaces")
+				}
+				`,
+				ExpectError: regexp.MustCompile("(?i)invalid.*indentation"),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONPatchFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_document" {
+					value = provider::prettyjson::jsonpatch("{invalid}", "[]")
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid JSON syntax detected"),
+			},
+			{
+				Config: `
+				output "test_empty_patch" {
+					value = provider::prettyjson::jsonpatch("{}", "")
+				}
+				`,
+				ExpectError: regexp.MustCompile("JSON input cannot be empty"),
+			},
+			{
+				Config: `
+				output "test_patch_not_array" {
+					value = provider::prettyjson::jsonpatch("{}", "{\"op\":\"add\"}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`JSON\s+Patch\s+must\s+be\s+an\s+array\s+of\s+operations`),
+			},
+			{
+				Config: `
+				output "test_unknown_op" {
+					value = provider::prettyjson::jsonpatch("{}", "[{\"op\":\"merge\",\"path\":\"/a\"}]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`operation\s+at\s+index\s+0\s+at\s+path\s+"/a"\s+has\s+unsupported\s+op\s+"merge"`),
+			},
+			{
+				Config: `
+				output "test_missing_value" {
+					value = provider::prettyjson::jsonpatch("{}", "[{\"op\":\"add\",\"path\":\"/a\"}]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`missing\s+the\s+required\s+"value"\s+member`),
+			},
+			{
+				Config: `
+				output "test_failed_test" {
+					value = provider::prettyjson::jsonpatch("{\"env\":\"dev\"}", "[{\"op\":\"add\",\"path\":\"/x\",\"value\":1},{\"op\":\"test\",\"path\":\"/env\",\"value\":\"prod\"}]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`operation\s+at\s+index\s+1\s+\(test\)\s+failed\s+at\s+path\s+"/env"`),
+			},
+			{
+				Config: `
+				output "test_missing_path" {
+					value = provider::prettyjson::jsonpatch("{\"a\":{}}", "[{\"op\":\"remove\",\"path\":\"/a/b\"}]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`operation\s+at\s+index\s+0\s+\(remove\)\s+failed\s+at\s+path\s+"/a/b"`),
+			},
+			{
+				Config: `
+				output "test_array_out_of_bounds" {
+					value = provider::prettyjson::jsonpatch("{\"list\":[1]}", "[{\"op\":\"add\",\"path\":\"/list/5\",\"value\":2}]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`out\s+of\s+bounds`),
+			},
+			{
+				Config: `
+				output "test_move_into_child" {
+					value = provider::prettyjson::jsonpatch("{\"a\":{\"b\":{}}}", "[{\"op\":\"move\",\"from\":\"/a\",\"path\":\"/a/b/c\"}]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`cannot\s+move\s+"/a"\s+into\s+one\s+of\s+its\s+own\s+children`),
+			},
+			{
+				Config: `
+				output "test_invalid_pointer" {
+					value = provider::prettyjson::jsonpatch("{}", "[{\"op\":\"add\",\"path\":\"a\",\"value\":1}]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`operation\s+at\s+index\s+0\s+\(add\)\s+has\s+an\s+invalid\s+path`),
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONPathFunction{}
+)
+
+func NewJSONPathFunction() function.Function {
+	return JSONPathFunction{}
+}
+
+type JSONPathFunction struct{}
+
+func (r JSONPathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpath")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonpath"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONPathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpath")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Query a JSON document with an RFC 9535 JSONPath expression",
+		MarkdownDescription: `Evaluates a JSONPath expression ([RFC 9535](https://www.rfc-editor.org/rfc/rfc9535)) against a JSON document and returns all matches as a pretty-printed JSON array.
+
+## Overview
+
+Matches are returned in document order. A query that matches nothing returns ` + "`[]`" + `. Values are copied from the document unchanged, so key order and number literals are preserved.
+
+## Supported Syntax
+
+- **Root and children**: ` + "`$`" + `, ` + "`$.spec.containers`" + `, ` + "`$['a key']`" + `
+- **Wildcards**: ` + "`$.items[*]`" + `, ` + "`$.metadata.*`" + `
+- **Indexes and slices**: ` + "`$.items[0]`" + `, ` + "`$.items[-1]`" + `, ` + "`$.items[1:5:2]`" + `, ` + "`$.items[::-1]`" + `
+- **Recursive descent**: ` + "`$..image`" + `, ` + "`$..[0]`" + `
+- **Filters**: ` + "`$.items[?@.enabled == true]`" + `, with ` + "`==`, `!=`, `<`, `<=`, `>`, `>=`, `&&`, `||`, `!`" + ` and parentheses
+- **Functions**: ` + "`length()`, `count()`, `match()`, `search()`" + ` and ` + "`value()`" + `, for example ` + "`$.users[?match(@.email, '.*@example\\.com')]`" + `
+
+Invalid expressions are rejected with the position of the offending character.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to query.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "The JSONPath expression, starting with `$`.\n\n**Example:** `\"$..containers[*].image\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional indentation type or options object.\n\n**Valid Indentation Types:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n\n**Options Object:**\n- `indentation_type` - One of the indentation types above\n\n**Example:**\n`{ indentation_type = \"tab\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpath")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSONPath function execution")
+
+	var documentString string
+	var expression string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &expression, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	indent, funcErr := parseIndentationOptionsArgument(ctx, options, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	query, funcErr := parseJSONPathArgument(ctx, expression, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	matches := query.Values(document)
+
+	tflog.Debug(ctx, "JSONPath query evaluated", map[string]any{
+		"match_count": len(matches),
+	})
+
+	result, funcErr := formatJSONResult(ctx, matches, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSONPath function execution successful", map[string]any{
+		"result_size": len(result),
+		"match_count": len(matches),
+	})
+}
+
+// parseJSONPathArgument parses a JSONPath expression argument.
+func parseJSONPathArgument(ctx context.Context, expression string, argumentPosition int64) (*jsonPathQuery, *function.FuncError) {
+	query, err := parseJSONPath(expression)
+	if err != nil {
+		tflog.Error(ctx, "Invalid JSONPath expression", map[string]any{
+			"error_type":        ErrorTypeValidation,
+			"error_code":        "INVALID_JSONPATH",
+			"argument_position": argumentPosition,
+			"error":             err.Error(),
+		})
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid JSONPath expression %v.", err))
+	}
+	return query, nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testJSONPathBookstore is the example document from RFC 9535, Figure 1.
+const testJSONPathBookstore = `
+locals {
+	store = jsonencode({
+		store = {
+			book = [
+				{ category = "reference", author = "Nigel Rees", title = "Sayings of the Century", price = 8.95 },
+				{ category = "fiction", author = "Evelyn Waugh", title = "Sword of Honour", price = 12.99 },
+				{ category = "fiction", author = "Herman Melville", title = "Moby Dick", isbn = "0-553-21311-3", price = 8.99 },
+				{ category = "fiction", author = "J. R. R. Tolkien", title = "The Lord of the Rings", isbn = "0-395-19395-8", price = 22.99 },
+			]
+			bicycle = { color = "red", price = 399 }
+		}
+	})
+}
+`
+
+// Acceptance test covering the examples from RFC 9535, Table 2.
+func TestJSONPathFunction_RFCExamples(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testJSONPathBookstore + `
+				output "test_all_authors" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$.store.book[*].author")))
+				}
+				output "test_recursive_authors" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$..author")))
+				}
+				output "test_store_prices" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$.store..price")))
+				}
+				output "test_third_book" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$..book[2].title")))
+				}
+				output "test_last_book" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$..book[-1].title")))
+				}
+				output "test_first_two_books" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$..book[:2].title")))
+				}
+				output "test_books_with_isbn" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$..book[?@.isbn].title")))
+				}
+				output "test_cheap_books" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.store, "$..book[?@.price<10].title")))
+				}
+				output "test_all_member_count" {
+					value = length(jsondecode(provider::prettyjson::jsonpath(local.store, "$..*")))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_all_authors", `["Nigel Rees","Evelyn Waugh","Herman Melville","J. R. R. Tolkien"]`),
+					resource.TestCheckOutput("test_recursive_authors", `["Nigel Rees","Evelyn Waugh","Herman Melville","J. R. R. Tolkien"]`),
+					resource.TestCheckOutput("test_store_prices", `[399,8.95,12.99,8.99,22.99]`),
+					resource.TestCheckOutput("test_third_book", `["Moby Dick"]`),
+					resource.TestCheckOutput("test_last_book", `["The Lord of the Rings"]`),
+					resource.TestCheckOutput("test_first_two_books", `["Sayings of the Century","Sword of Honour"]`),
+					resource.TestCheckOutput("test_books_with_isbn", `["Moby Dick","The Lord of the Rings"]`),
+					resource.TestCheckOutput("test_cheap_books", `["Sayings of the Century","Moby Dick"]`),
+					resource.TestCheckOutput("test_all_member_count", "27"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for filters, slices and function extensions.
+func TestJSONPathFunction_FiltersAndFunctions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					manifest = jsonencode({
+						spec = {
+							containers = [
+								{ name = "app", image = "nginx:1.27", enabled = true, ports = [80, 443] },
+								{ name = "sidecar", image = "envoy:1.30", enabled = false, ports = [] },
+							]
+							initContainers = [
+								{ name = "init", image = "busybox:1.36", enabled = true },
+							]
+						}
+					})
+				}
+				output "test_all_images" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.manifest, "$..image")))
+				}
+				output "test_enabled_filter" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.manifest, "$.spec.containers[?@.enabled == true].name")))
+				}
+				output "test_logical_operators" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.manifest, "$..[?(@.name == 'sidecar' || @.name == 'init') && !@.ports].name")))
+				}
+				output "test_length" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.manifest, "$.spec.containers[?length(@.ports) > 1].name")))
+				}
+				output "test_count" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.manifest, "$.spec[?count(@[*]) == 1][0].name")))
+				}
+				output "test_match" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.manifest, "$..[?match(@.image, 'nginx:.*')].name")))
+				}
+				output "test_search" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath(local.manifest, "$..[?search(@.image, 'oy')].name")))
+				}
+				output "test_slice_step" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath("[0,1,2,3,4,5,6]", "$[1:6:2]")))
+				}
+				output "test_reverse_slice" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpath("[0,1,2,3]", "$[::-1]")))
+				}
+				output "test_no_match" {
+					value = provider::prettyjson::jsonpath(local.manifest, "$.status")
+				}
+				output "test_formatted" {
+					value = provider::prettyjson::jsonpath("{\"a\":{\"z\":1,\"b\":1.50}}", "$.a", "4spaces")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_all_images", `["nginx:1.27","envoy:1.30","busybox:1.36"]`),
+					resource.TestCheckOutput("test_enabled_filter", `["app"]`),
+					resource.TestCheckOutput("test_logical_operators", `["init"]`),
+					resource.TestCheckOutput("test_length", `["app"]`),
+					resource.TestCheckOutput("test_count", `["init"]`),
+					resource.TestCheckOutput("test_match", `["app"]`),
+					resource.TestCheckOutput("test_search", `["sidecar"]`),
+					resource.TestCheckOutput("test_slice_step", `[1,3,5]`),
+					resource.TestCheckOutput("test_reverse_slice", `[3,2,1,0]`),
+					resource.TestCheckOutput("test_no_match", "[]"),
+					resource.TestCheckOutput("test_formatted", "[\n    {\n        \"z\": 1,\n        \"b\": 1.50\n    }\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONPathFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_missing_root" {
+					value = provider::prettyjson::jsonpath("{}", "store.book")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSONPath\s+expression\s+at\s+position\s+0:\s+query\s+must\s+start\s+with\s+'\$'`),
+			},
+			{
+				Config: `
+				output "test_unclosed_bracket" {
+					value = provider::prettyjson::jsonpath("{}", "$.items[0")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`at\s+position\s+9:\s+expected\s+']'`),
+			},
+			{
+				Config: `
+				output "test_non_singular_comparison" {
+					value = provider::prettyjson::jsonpath("{}", "$[?@.* == 1]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`only\s+singular\s+queries\s+can\s+be\s+compared`),
+			},
+			{
+				Config: `
+				output "test_unknown_function" {
+					value = provider::prettyjson::jsonpath("{}", "$[?size(@) == 1]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown\s+function\s+size\(\)`),
+			},
+			{
+				Config: `
+				output "test_invalid_document" {
+					value = provider::prettyjson::jsonpath("{invalid}", "$")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+syntax\s+detected`),
+			},
+		},
+	})
+}
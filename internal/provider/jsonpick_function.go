@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONPickFunction{}
+)
+
+func NewJSONPickFunction() function.Function {
+	return JSONPickFunction{}
+}
+
+type JSONPickFunction struct{}
+
+func (r JSONPickFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpick")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonpick"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONPickFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpick")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Keep only selected paths of a JSON document",
+		MarkdownDescription: `Returns a reduced JSON document that contains only the values at the given paths, together with the objects and arrays that contain them, for example to send only an allowlisted subset of a document to a downstream API.
+
+## Paths
+
+Each path is a JSON Pointer ([RFC 6901](https://www.rfc-editor.org/rfc/rfc6901)) or a glob-style path:
+
+- ` + "`\"/metadata/name\"`" + ` or ` + "`\"metadata.name\"`" + ` - a single member
+- ` + "`\"spec.containers[*].image\"`" + ` - ` + "`*`" + ` matches any key or index, and can be combined with text as in ` + "`\"*Timestamp\"`" + `
+- ` + "`\"**.name\"`" + ` - ` + "`**`" + ` matches any number of levels
+
+A backslash escapes ` + "`.`" + `, ` + "`[`" + `, ` + "`]`" + `, ` + "`*`" + `, ` + "`?`" + ` and itself within glob keys. Paths that match nothing are ignored.
+
+## Result
+
+Key order and number literals are preserved. Picked array elements keep their relative order and are renumbered from zero. When nothing matches, an empty object or array is returned.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to reduce.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.ListParameter{
+				Name:                "paths",
+				ElementType:         types.StringType,
+				MarkdownDescription: "JSON Pointers or glob-style paths of the values to keep.\n\n**Example:** `[\"metadata.name\", \"metadata.labels\", \"spec\"]`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ indentation_type = \"4spaces\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONPickFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpick")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON pick function execution")
+
+	var documentString string
+	var paths []string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &paths, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 2, "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	patterns, funcErr := parseJSONPathPatternsArgument(ctx, paths, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	picked, ok := pickJSONValue(document, jsonPointer{}, patterns)
+	if !ok {
+		switch document.(type) {
+		case *jsonObject:
+			picked = newJSONObject()
+		case []any:
+			picked = []any{}
+		}
+	}
+
+	result, funcErr := formatJSONResult(ctx, picked, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON pick function execution successful", map[string]any{
+		"result_size": len(result),
+		"path_count":  len(paths),
+	})
+}
+
+// parseJSONPathPatternsArgument parses a list of JSON Pointers and glob-style
+// paths passed as a function argument.
+func parseJSONPathPatternsArgument(ctx context.Context, paths []string, argumentPosition int64) (jsonPathPatterns, *function.FuncError) {
+	patterns, err := parseJSONPathPatterns(paths)
+	if err != nil {
+		tflog.Error(ctx, "Invalid path pattern", map[string]any{
+			"error_type":        ErrorTypeValidation,
+			"error_code":        "INVALID_PATH_PATTERN",
+			"argument_position": argumentPosition,
+			"error":             err.Error(),
+		})
+		return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid %v.", err))
+	}
+	return patterns, nil
+}
+
+// pickJSONValue returns the parts of value matched by the patterns. The
+// second result is false when nothing at or below location matched.
+func pickJSONValue(value any, location jsonPointer, patterns jsonPathPatterns) (any, bool) {
+	if patterns.Matches(location) {
+		return value, true
+	}
+	if !patterns.MayMatchBelow(location) {
+		return nil, false
+	}
+
+	switch v := value.(type) {
+	case *jsonObject:
+		picked := newJSONObject()
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			if pickedMember, ok := pickJSONValue(member, location.child(key), patterns); ok {
+				picked.Set(key, pickedMember)
+			}
+		}
+		return picked, picked.Len() > 0
+	case []any:
+		picked := []any{}
+		for i, element := range v {
+			if pickedElement, ok := pickJSONValue(element, location.child(strconv.Itoa(i)), patterns); ok {
+				picked = append(picked, pickedElement)
+			}
+		}
+		return picked, len(picked) > 0
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for picking with JSON Pointers and globs.
+func TestJSONPickFunction_Paths(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"metadata\":{\"name\":\"api\",\"uid\":\"123\",\"labels\":{\"app\":\"api\"}},\"spec\":{\"containers\":[{\"name\":\"app\",\"image\":\"api:1\",\"port\":8080},{\"name\":\"sidecar\",\"image\":\"envoy:1\"}]},\"a.b\":1}"
+				}
+				output "test_pointers_key_order" {
+					value = provider::prettyjson::jsonpick(local.doc, ["/metadata/labels", "/metadata/name", "/missing"])
+				}
+				output "test_glob_wildcard_index" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpick(local.doc, ["spec.containers[*].image"])))
+				}
+				output "test_glob_index" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpick(local.doc, ["spec.containers[1].name"])))
+				}
+				output "test_glob_any_depth" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpick(local.doc, ["**.name"])))
+				}
+				output "test_glob_escaped_dot" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpick(local.doc, ["a\\.b"])))
+				}
+				output "test_glob_escaped_star" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpick("{\"*a\":1,\"b\":{\"*c\":2},\"d\":3}", ["\\**"])))
+				}
+				output "test_glob_non_ascii" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonpick("{\"café\":1,\"cafés\":{\"x\":1},\"cafe\":2,\"a\\nb\":3}", ["café*", "a?b"])))
+				}
+				output "test_nothing_matched" {
+					value = provider::prettyjson::jsonpick(local.doc, ["status"])
+				}
+				output "test_4spaces" {
+					value = provider::prettyjson::jsonpick(local.doc, ["metadata.n*"], { indentation_type = "4spaces" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_pointers_key_order", "{\n  \"metadata\": {\n    \"name\": \"api\",\n    \"labels\": {\n      \"app\": \"api\"\n    }\n  }\n}"),
+					resource.TestCheckOutput("test_glob_wildcard_index", `{"spec":{"containers":[{"image":"api:1"},{"image":"envoy:1"}]}}`),
+					resource.TestCheckOutput("test_glob_index", `{"spec":{"containers":[{"name":"sidecar"}]}}`),
+					resource.TestCheckOutput("test_glob_any_depth", `{"metadata":{"name":"api"},"spec":{"containers":[{"name":"app"},{"name":"sidecar"}]}}`),
+					resource.TestCheckOutput("test_glob_escaped_dot", `{"a.b":1}`),
+					resource.TestCheckOutput("test_glob_escaped_star", `{"*a":1}`),
+					resource.TestCheckOutput("test_glob_non_ascii", `{"a\nb":3,"café":1,"cafés":{"x":1}}`),
+					resource.TestCheckOutput("test_nothing_matched", "{}"),
+					resource.TestCheckOutput("test_4spaces", "{\n    \"metadata\": {\n        \"name\": \"api\"\n    }\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONPickFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_glob_index" {
+					value = provider::prettyjson::jsonpick("{}", ["spec", "items[x]"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+path\s+at\s+index\s+1:\s+glob\s+"items\[x\]"\s+has\s+an\s+invalid\s+array\s+index`),
+			},
+			{
+				Config: `
+				output "test_invalid_pointer" {
+					value = provider::prettyjson::jsonpick("{}", ["/a~2"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+path\s+at\s+index\s+0`),
+			},
+			{
+				Config: `
+				output "test_index_after_dot" {
+					value = provider::prettyjson::jsonpick("{}", ["a.[0]"])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`glob\s+"a\.\[0\]"\s+has\s+an\s+array\s+index\s+after\s+'\.'`),
+			},
+		},
+	})
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONPointerFunction{}
+)
+
+// Output modes supported by jsonpointer.
+const (
+	PointerOutputJSON  = "json"
+	PointerOutputValue = "value"
+)
+
+func NewJSONPointerFunction() function.Function {
+	return JSONPointerFunction{}
+}
+
+type JSONPointerFunction struct{}
+
+func (r JSONPointerFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpointer")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonpointer"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONPointerFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpointer")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Read a value from a JSON document by RFC 6901 JSON Pointer",
+		MarkdownDescription: `Returns the value a JSON Pointer ([RFC 6901](https://www.rfc-editor.org/rfc/rfc6901)) refers to within a JSON document.
+
+## Overview
+
+The document is never converted to Terraform types, so a single value can be extracted without ` + "`jsondecode()`" + ` and its type-conversion problems (mixed-type arrays, large numbers, key order):
+
+- **JSON output** (default): the value is returned as a JSON string, formatted with the same indentation options as ` + "`jsonprettyprint`" + `. Strings are returned quoted, for example ` + "`\"eu-west-1\"`" + `.
+- **Value output**: with ` + "`{ output = \"value\" }`" + ` the value is returned as a Terraform value, using the same types as ` + "`jsondecode()`" + `.
+
+## Missing Values
+
+When the pointer does not resolve, the optional default value is returned instead. In JSON output mode the default is encoded as JSON. Without a default the function fails and reports the deepest prefix of the pointer that did resolve, for example ` + "`deepest resolved prefix: \"/spec/template\"`" + `. A ` + "`null`" + ` default is treated as no default.
+
+## Default and Options
+
+The default and the options object share the variadic argument. A single object whose keys are all option names (` + "`output`" + `, ` + "`indentation_type`" + ` and ` + "`default`" + `) is read as the options object, so ` + "`jsonpointer(doc, \"/spec/image\", { output = \"value\" })`" + ` needs no placeholder default. Any other value, including ` + "`{}`" + `, is the default. To use an object of that shape as the default, give it as the ` + "`default`" + ` option, which holds the default as it would appear in the document.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to read from.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "pointer",
+				MarkdownDescription: "The JSON Pointer to resolve. Use `\"\"` for the whole document, `~1` for `/` and `~0` for `~` within keys.\n\n**Example:** `\"/spec/containers/0/image\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "default",
+			MarkdownDescription: "Optional default value returned when the pointer does not resolve, optionally followed by an options object. A single object whose keys are all option names is the options object.\n\n**Options Object:**\n- `output` - `\"json\"` (default) or `\"value\"`\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n- `default` - the default value, instead of giving it before the options\n\n**Examples:**\n- `jsonpointer(doc, \"/replicas\", 1, { output = \"value\" })`\n- `jsonpointer(doc, \"/image\", { output = \"value\" })` - options without a default\n- `jsonpointer(doc, \"/replicas\", { default = 1, output = \"value\" })`",
+			AllowNullValue:      true,
+		},
+		Return: function.DynamicReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "dynamic",
+	})
+}
+
+func (r JSONPointerFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonpointer")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON pointer function execution")
+
+	var documentString string
+	var pointerString string
+	var trailing []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &pointerString, &trailing))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	// The variadic argument carries the default value followed by an
+	// optional options object. A single object whose members are all
+	// options is the options object rather than the default.
+	var defaultValue types.Dynamic
+	if len(trailing) > 0 && !(len(trailing) == 1 && isJSONPointerOptions(ctx, trailing[0])) {
+		defaultValue = trailing[0]
+		trailing = trailing[1:]
+	}
+	hasDefault := !defaultValue.IsNull() && !defaultValue.IsUnderlyingValueNull()
+
+	opts, funcErr := parseOptionsArgument(ctx, trailing, 3, jsonPointerOptionNames...)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	// The default option holds the default as JSON, converted like a
+	// resolved value below.
+	defaultOption, hasDefaultOption := opts.values.Get("default")
+	hasDefaultOption = hasDefaultOption && defaultOption != nil
+	if hasDefault && hasDefaultOption {
+		resp.Error = function.NewArgumentFuncError(opts.argumentPosition, "The default value cannot be given both as an argument and as the \"default\" option.")
+		return
+	}
+
+	output, funcErr := opts.stringOption("output", PointerOutputJSON, PointerOutputJSON, PointerOutputValue)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	pointer, funcErr := parseJSONPointerArgument(ctx, pointerString, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	value, err := pointer.Resolve(document)
+	if err != nil {
+		if !hasDefault && !hasDefaultOption {
+			tflog.Error(ctx, "JSON Pointer did not resolve", map[string]any{
+				"error_type": ErrorTypeProcessing,
+				"error_code": "JSON_POINTER_NOT_FOUND",
+				"pointer":    pointerString,
+				"error":      err.Error(),
+			})
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("JSON Pointer %s.", err))
+			return
+		}
+
+		tflog.Debug(ctx, "JSON Pointer did not resolve, returning default", map[string]any{
+			"pointer": pointerString,
+			"reason":  err.Error(),
+		})
+		switch {
+		case hasDefaultOption:
+			value = defaultOption
+		case output == PointerOutputValue:
+			resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, defaultValue))
+			return
+		default:
+			value, err = terraformValueToJSON(ctx, defaultValue)
+			if err != nil {
+				resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid default value: %v.", err))
+				return
+			}
+		}
+	}
+
+	var result types.Dynamic
+	if output == PointerOutputValue {
+		converted, err := jsonToTerraformValue(ctx, value)
+		if err != nil {
+			tflog.Error(ctx, "Failed to convert value", map[string]any{
+				"error_type": ErrorTypeProcessing,
+				"error_code": "VALUE_CONVERSION_ERROR",
+				"error":      err.Error(),
+			})
+			resp.Error = function.NewFuncError(fmt.Sprintf("Failed to convert value at %q: %v.", pointerString, err))
+			return
+		}
+		result = dynamicResult(converted)
+	} else {
+		formatted, funcErr := formatJSONResult(ctx, value, indent)
+		if funcErr != nil {
+			resp.Error = funcErr
+			return
+		}
+		result = types.DynamicValue(types.StringValue(formatted))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON pointer function execution successful", map[string]any{
+		"pointer":    pointerString,
+		"output":     output,
+		"value_type": jsonTypeName(value),
+	})
+}
+
+// dynamicResult wraps a converted value for a dynamic function result. Values
+// that are already dynamic, such as a JSON null, are returned unchanged.
+func dynamicResult(value attr.Value) types.Dynamic {
+	if dynamic, ok := value.(types.Dynamic); ok {
+		return dynamic
+	}
+	return types.DynamicValue(value)
+}
+
+// jsonPointerOptionNames are the members of the jsonpointer options object.
+var jsonPointerOptionNames = []string{"output", "indentation_type", "default"}
+
+// isJSONPointerOptions reports whether an argument is an options object: a
+// non-empty object whose members are all options. Other values, including
+// the empty object, are default values.
+func isJSONPointerOptions(ctx context.Context, argument types.Dynamic) bool {
+	decoded, err := terraformValueToJSON(ctx, argument)
+	if err != nil {
+		return false
+	}
+	object, ok := decoded.(*jsonObject)
+	if !ok || object.Len() == 0 {
+		return false
+	}
+	for _, key := range object.Keys() {
+		if !slices.Contains(jsonPointerOptionNames, key) {
+			return false
+		}
+	}
+	return true
+}
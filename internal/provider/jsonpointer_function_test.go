@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for JSON output of resolved values.
+func TestJSONPointerFunction_JSONOutput(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"spec\":{\"replicas\":3,\"image\":\"nginx:1.27\",\"ports\":[80,443],\"limits\":{\"memory\":\"1Gi\",\"cpu\":\"2\"}},\"a/b\":1,\"m~n\":2,\"big\":12345678901234567890}"
+				}
+				output "test_string" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/spec/image")
+				}
+				output "test_number" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/spec/replicas")
+				}
+				output "test_array_element" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/spec/ports/1")
+				}
+				output "test_object_key_order" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/spec/limits")
+				}
+				output "test_escaped_slash" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/a~1b")
+				}
+				output "test_escaped_tilde" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/m~0n")
+				}
+				output "test_big_number" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/big")
+				}
+				output "test_4spaces" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/spec/ports", null, { indentation_type = "4spaces" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_string", "\"nginx:1.27\""),
+					resource.TestCheckOutput("test_number", "3"),
+					resource.TestCheckOutput("test_array_element", "443"),
+					resource.TestCheckOutput("test_object_key_order", "{\n  \"memory\": \"1Gi\",\n  \"cpu\": \"2\"\n}"),
+					resource.TestCheckOutput("test_escaped_slash", "1"),
+					resource.TestCheckOutput("test_escaped_tilde", "2"),
+					resource.TestCheckOutput("test_big_number", "12345678901234567890"),
+					resource.TestCheckOutput("test_4spaces", "[\n    80,\n    443\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for value output and defaults.
+func TestJSONPointerFunction_ValueOutputAndDefaults(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"name\":\"api\",\"replicas\":3,\"tags\":[\"a\",1,true],\"enabled\":false,\"owner\":null}"
+				}
+				output "test_value_string" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/name", null, { output = "value" })
+				}
+				output "test_value_number" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/replicas", null, { output = "value" }) + 1
+				}
+				output "test_value_mixed_tuple" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/tags", null, { output = "value" })[1]
+				}
+				output "test_value_bool" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/enabled", null, { output = "value" })
+				}
+				output "test_value_null" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/owner", "nobody", { output = "value" }) == null
+				}
+				output "test_default_json" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/region", "eu-west-1")
+				}
+				output "test_default_object_json" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/resources", { cpu = "1" })
+				}
+				output "test_default_value" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/timeout", 30, { output = "value" })
+				}
+				output "test_options_alone" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/name", { output = "value" })
+				}
+				output "test_default_option" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/timeout", { default = 30, output = "value" }) + 1
+				}
+				output "test_default_option_json" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/resources", { default = { output = "value" } })
+				}
+				output "test_empty_object_default" {
+					value = provider::prettyjson::jsonpointer(local.doc, "/resources", {})
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_value_string", "api"),
+					resource.TestCheckOutput("test_value_number", "4"),
+					resource.TestCheckOutput("test_value_mixed_tuple", "1"),
+					resource.TestCheckOutput("test_value_bool", "false"),
+					resource.TestCheckOutput("test_value_null", "true"),
+					resource.TestCheckOutput("test_default_json", "\"eu-west-1\""),
+					resource.TestCheckOutput("test_default_object_json", "{\n  \"cpu\": \"1\"\n}"),
+					resource.TestCheckOutput("test_default_value", "30"),
+					resource.TestCheckOutput("test_options_alone", "api"),
+					resource.TestCheckOutput("test_default_option", "31"),
+					resource.TestCheckOutput("test_default_option_json", "{\n  \"output\": \"value\"\n}"),
+					resource.TestCheckOutput("test_empty_object_default", "{}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONPointerFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_missing_member" {
+					value = provider::prettyjson::jsonpointer("{\"spec\":{\"template\":{}}}", "/spec/template/metadata/name")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`member\s+"metadata"\s+does\s+not\s+exist\s+\(deepest\s+resolved\s+prefix:\s+"/spec/template"\)`),
+			},
+			{
+				Config: `
+				output "test_index_out_of_bounds" {
+					value = provider::prettyjson::jsonpointer("{\"ports\":[80]}", "/ports/1")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+index\s+1\s+is\s+out\s+of\s+bounds.*deepest\s+resolved\s+prefix:\s+"/ports"`),
+			},
+			{
+				Config: `
+				output "test_invalid_pointer" {
+					value = provider::prettyjson::jsonpointer("{}", "spec")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"pointer"\s+parameter:\s+Invalid\s+JSON\s+Pointer`),
+			},
+			{
+				Config: `
+				output "test_invalid_document" {
+					value = provider::prettyjson::jsonpointer("{invalid}", "")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+syntax\s+detected`),
+			},
+			{
+				Config: `
+				output "test_invalid_output" {
+					value = provider::prettyjson::jsonpointer("{}", "", null, { output = "yaml" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+"yaml"\s+for\s+option\s+"output"`),
+			},
+			{
+				Config: `
+				output "test_two_defaults" {
+					value = provider::prettyjson::jsonpointer("{}", "/a", 1, { default = 2 })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`default\s+value\s+cannot\s+be\s+given\s+both\s+as\s+an\s+argument\s+and\s+as\s+the\s+"default"\s+option`),
+			},
+		},
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -69,11 +70,29 @@ func (r JSONPrettyPrintFunction) Definition(ctx context.Context, req function.De
 
 This function takes a JSON string and returns a formatted version with consistent indentation and line breaks. It validates the input JSON syntax and provides detailed error messages for invalid input.
 
+Input that is already formatted exactly as the function would format it is returned unchanged without being decoded and encoded again, so repeated plans of formatted documents stay cheap.
+
 ## Supported Indentation Types
 
 - **2spaces** (default): Two-space indentation, commonly used in JavaScript and many style guides
 - **4spaces**: Four-space indentation, popular in Python and many enterprise coding standards  
 - **tab**: Tab character indentation, preferred by some development teams
+- **preserve**: The indentation the input already uses, so that documents owned by others keep their style while being normalized. Tabs and indent units of up to 8 spaces, such as 2, 3 or 4, are detected from the input's lines. Minified input, which has no indentation to detect, is formatted with ` + "`indentation_fallback`" + `
+
+The indentation type can be passed directly as the second argument, or as the ` + "`indentation_type`" + ` member of an options object.
+
+## Options
+
+- ` + "`indentation_type`" + ` - One of the indentation types above
+- ` + "`indentation_fallback`" + ` - The indentation type for input without indentation in ` + "`preserve`" + ` mode: ` + "`\"2spaces\"`" + ` (default), ` + "`\"4spaces\"`" + ` or ` + "`\"tab\"`" + `
+- ` + "`sort_arrays`" + ` - Sort rules for arrays whose element order is not meaningful, as described below
+- ` + "`prune`" + ` - ` + "`true`" + ` to remove ` + "`null`" + ` values, empty objects and empty arrays recursively, or an object with these options:
+
+` + pruneOptionsDescription + `
+
+Pruning happens before sorting, and the document itself is never removed.
+
+` + arraySortRulesDescription + `
 
 ## Input Validation
 
@@ -98,9 +117,9 @@ Provides comprehensive error messages for:
 				AllowUnknownValues:  false,
 			},
 		},
-		VariadicParameter: function.StringParameter{
-			Name:                "indentation_type",
-			MarkdownDescription: "Optional parameter to specify the indentation style for formatting.\n\n**Valid Options:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n\n**Default Behavior:**\nIf not specified, defaults to `\"2spaces\"` indentation.\n\n**Examples:**\n- `provider::prettyjson::jsonprettyprint(json_string)` - Uses default 2-space indentation\n- `provider::prettyjson::jsonprettyprint(json_string, \"4spaces\")` - Uses 4-space indentation\n- `provider::prettyjson::jsonprettyprint(json_string, \"tab\")` - Uses tab indentation\n\n**Error Handling:**\nInvalid indentation types will result in a clear error message listing valid options.",
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional indentation type or options object.\n\n**Valid Indentation Types:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n- `\"preserve\"` - Indentation detected from the input\n\n**Options Object:**\n- `indentation_type` - One of the indentation types above\n- `indentation_fallback` - Indentation type for minified input in `\"preserve\"` mode (default `\"2spaces\"`)\n- `sort_arrays` - A sort rule or a list of sort rules\n- `prune` - `true` or an object with `nulls`, `empty_objects`, `empty_arrays`, `empty_strings` and `exclude`\n\n**Default Behavior:**\nIf not specified, defaults to `\"2spaces\"` indentation, arrays keep their order and no values are removed.\n\n**Examples:**\n- `provider::prettyjson::jsonprettyprint(json_string)` - Uses default 2-space indentation\n- `provider::prettyjson::jsonprettyprint(json_string, \"4spaces\")` - Uses 4-space indentation\n- `provider::prettyjson::jsonprettyprint(json_string, \"tab\")` - Uses tab indentation\n- `provider::prettyjson::jsonprettyprint(json_string, { sort_arrays = [\"Statement[*].Action\"] })` - Sorts policy actions\n\n**Error Handling:**\nInvalid indentation types will result in a clear error message listing valid options.",
 			AllowNullValue:      true,
 		},
 		Return: function.StringReturn{},
@@ -131,11 +150,11 @@ func (r JSONPrettyPrintFunction) Run(ctx context.Context, req function.RunReques
 	tflog.Debug(ctx, "Starting JSON pretty-print function execution")
 
 	var jsonString string
-	var indentationTypes []string
+	var options []types.Dynamic
 
 	// Extract required json_string parameter
 	tflog.Trace(ctx, "Extracting json_string parameter")
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &jsonString, &indentationTypes))
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &jsonString, &options))
 	if resp.Error != nil {
 		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
 			"error": resp.Error.Error(),
@@ -145,103 +164,69 @@ func (r JSONPrettyPrintFunction) Run(ctx context.Context, req function.RunReques
 
 	// Log input characteristics for performance monitoring
 	inputSize := len(jsonString)
-	hasVariadicParam := len(indentationTypes) > 0
+	hasVariadicParam := len(options) > 0
 	tflog.Debug(ctx, "Input parameters extracted", map[string]any{
 		"input_size_bytes":   inputSize,
 		"input_size_chars":   len([]rune(jsonString)),
 		"has_variadic_param": hasVariadicParam,
-		"variadic_count":     len(indentationTypes),
+		"variadic_count":     len(options),
 	})
 
-	// Task 5.5: Performance monitoring - Log warning for large JSON inputs
-	if inputSize > LargeJSONWarningSize {
-		tflog.Warn(ctx, "Large JSON input detected", map[string]any{
-			"warning_type":   "PERFORMANCE_WARNING",
-			"size_bytes":     inputSize,
-			"size_mb":        float64(inputSize) / (1024 * 1024),
-			"threshold_mb":   LargeJSONWarningSize / (1024 * 1024),
-			"recommendation": "Consider reducing JSON size for better performance",
-		})
-	}
-
-	// Determine indentation type with default value
-	var indentationType string
-	if hasVariadicParam {
-		indentationType = indentationTypes[0]
-		tflog.Debug(ctx, "Using provided indentation type", map[string]any{
-			"indentation_type": indentationType,
-		})
-	} else {
-		indentationType = "2spaces"
-		tflog.Debug(ctx, "Using default indentation type", map[string]any{
-			"default_indentation": indentationType,
-		})
+	// Determine indentation type and transformations with default values
+	opts, funcErr := parsePrettyPrintOptions(ctx, options, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
 	}
+	indentationType := opts.indentationType
 
 	tflog.Debug(ctx, "Function parameters processed", map[string]any{
 		"indentation_type": indentationType,
 		"input_size":       inputSize,
 	})
 
-	// Task 4.1: JSON Validation Logic
-	tflog.Debug(ctx, "Starting JSON validation", map[string]any{
-		"input_size": inputSize,
-	})
-
-	// Task 5.1 & 5.3: Enhanced input validation with size limits and error classification
-	if inputSize == 0 {
-		tflog.Error(ctx, "Empty JSON input provided", map[string]any{
-			"error_type": ErrorTypeValidation,
-			"error_code": "EMPTY_INPUT",
-		})
-		resp.Error = function.NewArgumentFuncError(0, "JSON input cannot be empty. Please provide a valid JSON string.")
+	// Task 5.1, 5.3 & 5.4: Shared input validation with size limits and error classification
+	if funcErr := validateJSONInput(ctx, jsonString, 0); funcErr != nil {
+		resp.Error = funcErr
 		return
 	}
 
-	// Task 5.3: Enforce maximum input size limit
-	if inputSize > MaxJSONSize {
-		tflog.Error(ctx, "JSON input exceeds maximum size limit", map[string]any{
-			"error_type":    ErrorTypeValidation,
-			"error_code":    "SIZE_LIMIT_EXCEEDED",
-			"input_size":    inputSize,
-			"max_size":      MaxJSONSize,
-			"size_limit_mb": MaxJSONSize / (1024 * 1024),
-		})
-		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
-			"JSON input size (%d bytes) exceeds maximum allowed size of %d MB. "+
-				"Please reduce the JSON size or split into smaller chunks.",
-			inputSize, MaxJSONSize/(1024*1024)))
+	// Task 7: Validate indentation type parameter with descriptive error messages
+	indent, funcErr := opts.indent(ctx, jsonString)
+	if funcErr != nil {
+		resp.Error = funcErr
 		return
 	}
 
-	// Fast JSON validation using json.Valid()
-	validationStart := time.Now()
-	var validationDuration time.Duration
-	if !json.Valid([]byte(jsonString)) {
-		validationDuration = time.Since(validationStart)
-
-		// Task 5.4: Context-aware error messages with remediation suggestions
-		tflog.Error(ctx, "JSON validation failed", map[string]any{
-			"error_type":         ErrorTypeValidation,
-			"error_code":         "INVALID_JSON_SYNTAX",
-			"input_preview":      truncateString(jsonString, 100),
-			"validation_time_ms": validationDuration.Milliseconds(),
-			"input_size":         inputSize,
+	// Input that is already formatted as requested is returned as is,
+	// without decoding and encoding it again
+	if !opts.transforms() && isPrettyJSON(jsonString, indent) {
+		tflog.Debug(ctx, "Input is already pretty-printed, returning it unchanged", map[string]any{
+			"indentation_type": indentationType,
 		})
 
-		// Enhanced error message with context and remediation suggestions
-		resp.Error = function.NewArgumentFuncError(0,
-			"Invalid JSON syntax detected. Common issues include: "+
-				"missing quotes around strings, trailing commas, unescaped characters, "+
-				"or mismatched brackets/braces. Please validate your JSON using a JSON "+
-				"validator tool and ensure proper formatting.")
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, jsonString))
+		if resp.Error != nil {
+			tflog.Error(ctx, "Failed to set function result", map[string]any{
+				"error": resp.Error.Error(),
+			})
+			return
+		}
+
+		tflog.Info(ctx, "JSON pretty-print function execution successful", map[string]any{
+			"result_size":      len(jsonString),
+			"indentation_type": indentationType,
+			"input_size":       inputSize,
+			"unchanged":        true,
+		})
 		return
 	}
 
-	validationDuration = time.Since(validationStart)
-	tflog.Debug(ctx, "JSON validation successful", map[string]any{
-		"validation_time_ms": validationDuration.Milliseconds(),
-	})
+	data, funcErr := opts.transform(ctx, jsonString)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
 
 	// Task 4.2: JSON Parsing functionality
 	tflog.Debug(ctx, "Starting JSON parsing for structure validation")
@@ -249,7 +234,7 @@ func (r JSONPrettyPrintFunction) Run(ctx context.Context, req function.RunReques
 	var jsonData any
 	parseStart := time.Now()
 	var parseDuration time.Duration
-	if err := json.Unmarshal([]byte(jsonString), &jsonData); err != nil {
+	if err := json.Unmarshal(data, &jsonData); err != nil {
 		parseDuration = time.Since(parseStart)
 
 		// Task 5.1 & 5.4: Enhanced error classification and context-aware messages
@@ -281,37 +266,6 @@ func (r JSONPrettyPrintFunction) Run(ctx context.Context, req function.RunReques
 		"indentation_type": indentationType,
 	})
 
-	// Task 7: Validate indentation type parameter with descriptive error messages
-	var indent string
-	switch indentationType {
-	case "2spaces":
-		indent = "  "
-	case "4spaces":
-		indent = "    "
-	case "tab":
-		indent = "\t"
-	case "":
-		// Default to 2 spaces when no indentation type specified
-		indent = "  "
-		tflog.Debug(ctx, "Using default indentation (no type specified)", map[string]any{
-			"default_indent": "2spaces",
-		})
-	default:
-		// Task 7: Explicit validation with descriptive error messages for invalid indentation types
-		tflog.Error(ctx, "Invalid indentation type provided", map[string]any{
-			"error_type":    ErrorTypeValidation,
-			"error_code":    "INVALID_INDENTATION_TYPE",
-			"provided_type": indentationType,
-			"valid_types":   []string{"2spaces", "4spaces", "tab"},
-		})
-
-		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
-			"Invalid indentation type '%s'. Valid options are: '2spaces', '4spaces', or 'tab'. "+
-				"Please specify one of the supported indentation types for proper JSON formatting.",
-			indentationType))
-		return
-	}
-
 	// Pretty-print with proper indentation
 	formatStart := time.Now()
 	prettyJSON, err := json.MarshalIndent(jsonData, "", indent)
@@ -361,6 +315,111 @@ func (r JSONPrettyPrintFunction) Run(ctx context.Context, req function.RunReques
 	})
 }
 
+// prettyPrintOptions holds the decoded variadic argument of jsonprettyprint.
+type prettyPrintOptions struct {
+	indentationType     string
+	indentationFallback string
+	sortArrays          jsonArraySortRules
+	prune               *jsonPruner
+}
+
+// parsePrettyPrintOptions decodes the variadic argument of jsonprettyprint,
+// which is either an indentation type string or an options object.
+func parsePrettyPrintOptions(ctx context.Context, options []types.Dynamic, argumentPosition int64) (prettyPrintOptions, *function.FuncError) {
+	result := prettyPrintOptions{indentationType: DefaultIndentationType, indentationFallback: DefaultIndentationType}
+
+	if len(options) > 0 {
+		decoded, err := terraformValueToJSON(ctx, options[0])
+		if err != nil {
+			return result, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid options: %v.", err))
+		}
+		switch v := decoded.(type) {
+		case nil:
+			return result, nil
+		case string:
+			result.indentationType = indentationTypeArgument(ctx, []string{v})
+			return result, nil
+		}
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, argumentPosition, "indentation_type", "indentation_fallback", "sort_arrays", "prune")
+	if funcErr != nil {
+		return result, funcErr
+	}
+
+	result.indentationType, funcErr = opts.stringOption("indentation_type", DefaultIndentationType)
+	if funcErr != nil {
+		return result, funcErr
+	}
+
+	result.indentationFallback, funcErr = opts.stringOption("indentation_fallback", DefaultIndentationType, validIndentationTypes...)
+	if funcErr != nil {
+		return result, funcErr
+	}
+
+	if value, ok := opts.values.Get("sort_arrays"); ok && value != nil {
+		rules, err := parseArraySortRules(value)
+		if err != nil {
+			return result, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid option \"sort_arrays\": %v.", err))
+		}
+		result.sortArrays = rules
+	}
+
+	if value, ok := opts.values.Get("prune"); ok {
+		result.prune, funcErr = parsePruneOption(ctx, value, argumentPosition)
+	}
+	return result, funcErr
+}
+
+// indent resolves the indentation type to an indent string. In preserve
+// mode, the indentation of the input is kept, or the fallback used when the
+// input has no indented lines.
+func (o prettyPrintOptions) indent(ctx context.Context, jsonString string) (string, *function.FuncError) {
+	if o.indentationType != IndentationTypePreserve {
+		return resolveIndentation(ctx, o.indentationType, 1)
+	}
+
+	if indent, ok := detectIndentation(jsonString); ok {
+		tflog.Debug(ctx, "Preserving detected indentation", map[string]any{
+			"detected_indent": indent,
+		})
+		return indent, nil
+	}
+
+	tflog.Debug(ctx, "No indentation detected, using fallback", map[string]any{
+		"indentation_fallback": o.indentationFallback,
+	})
+	return resolveIndentation(ctx, o.indentationFallback, 1)
+}
+
+// transforms reports whether any document transformation is requested.
+func (o prettyPrintOptions) transforms() bool {
+	return len(o.sortArrays) > 0 || o.prune != nil
+}
+
+// transform applies the requested document transformations and returns the
+// JSON text to format. Without transformations the input is returned as is.
+func (o prettyPrintOptions) transform(ctx context.Context, jsonString string) ([]byte, *function.FuncError) {
+	if !o.transforms() {
+		return []byte(jsonString), nil
+	}
+
+	document, funcErr := parseJSONArgument(ctx, jsonString, 0)
+	if funcErr != nil {
+		return nil, funcErr
+	}
+	if o.prune != nil {
+		document, _ = o.prune.prune(document, jsonPointer{})
+	}
+	o.sortArrays.sort(document, jsonPointer{})
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, function.NewFuncError(fmt.Sprintf("JSON formatting failed: %v.", err))
+	}
+	return data, nil
+}
+
 // Helper function to truncate strings for logging.
 func truncateString(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -4,9 +4,14 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
@@ -16,8 +21,8 @@ import (
 // The acceptance tests below provide comprehensive coverage using the terraform-plugin-testing
 // framework, which is the recommended approach for testing Terraform provider functions.
 
-// Note: Benchmark tests would require proper argument marshalling for direct function calls.
-// Performance can be validated through the acceptance tests and actual Terraform usage.
+// Note: Benchmarks call Run directly with arguments marshalled as the framework
+// passes them, the variadic options as a tuple of dynamic values.
 
 // Acceptance tests using terraform-plugin-testing framework.
 func TestJSONPrettyPrintFunction_Basic(t *testing.T) {
@@ -706,3 +711,230 @@ func TestJSONPrettyPrintFunction_PerformanceStressTest(t *testing.T) {
 		},
 	})
 }
+
+// Acceptance test for the options object and the sort_arrays option.
+func TestJSONPrettyPrintFunction_SortArrays(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_options_indentation" {
+					value = provider::prettyjson::jsonprettyprint("{\"b\":[2,1],\"a\":1}", { indentation_type = "tab" })
+				}
+				output "test_sort_scalars" {
+					value = provider::prettyjson::jsonprettyprint("{\"b\":[\"y\",\"x\"],\"a\":[2,1]}", { sort_arrays = "b" })
+				}
+				output "test_sort_objects" {
+					value = provider::prettyjson::jsonprettyprint("[{\"n\":\"b\",\"v\":1},{\"n\":\"a\",\"v\":2}]", { indentation_type = "4spaces", sort_arrays = [{ path = "", by = "n" }] })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_options_indentation", "{\n\t\"a\": 1,\n\t\"b\": [\n\t\t2,\n\t\t1\n\t]\n}"),
+					resource.TestCheckOutput("test_sort_scalars", "{\n  \"a\": [\n    2,\n    1\n  ],\n  \"b\": [\n    \"x\",\n    \"y\"\n  ]\n}"),
+					resource.TestCheckOutput("test_sort_objects", "[\n    {\n        \"n\": \"a\",\n        \"v\": 2\n    },\n    {\n        \"n\": \"b\",\n        \"v\": 1\n    }\n]"),
+				),
+			},
+			{
+				Config: `
+				output "test_invalid_sort_arrays" {
+					value = provider::prettyjson::jsonprettyprint("[]", { sort_arrays = 1 })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+option\s+"sort_arrays":\s+rules\s+must\s+be\s+a\s+list`),
+			},
+			{
+				Config: `
+				output "test_unknown_option" {
+					value = provider::prettyjson::jsonprettyprint("[]", { sort = "**" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Unknown\s+option\s+"sort"`),
+			},
+		},
+	})
+}
+
+// Acceptance test for the prune option.
+func TestJSONPrettyPrintFunction_Prune(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = jsonencode({ name = "web", description = null, tags = {}, ports = [], env = { A = "" } })
+				}
+				output "test_prune_defaults" {
+					value = provider::prettyjson::jsonprettyprint(local.doc, { prune = true })
+				}
+				output "test_prune_options" {
+					value = provider::prettyjson::jsonprettyprint(local.doc, { prune = { empty_strings = true, exclude = ["tags"] }, indentation_type = "tab" })
+				}
+				output "test_prune_disabled" {
+					value = provider::prettyjson::jsonprettyprint("{\"a\":null}", { prune = false })
+				}
+				output "test_prune_and_sort" {
+					value = provider::prettyjson::jsonprettyprint("[3,null,1]", { prune = true, sort_arrays = "" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_prune_defaults", "{\n  \"env\": {\n    \"A\": \"\"\n  },\n  \"name\": \"web\"\n}"),
+					resource.TestCheckOutput("test_prune_options", "{\n\t\"name\": \"web\",\n\t\"tags\": {}\n}"),
+					resource.TestCheckOutput("test_prune_disabled", "{\n  \"a\": null\n}"),
+					resource.TestCheckOutput("test_prune_and_sort", "[\n  1,\n  3\n]"),
+				),
+			},
+			{
+				Config: `
+				output "test_invalid_prune" {
+					value = provider::prettyjson::jsonprettyprint("{}", { prune = { strings = true } })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Unknown\s+option\s+"strings"`),
+			},
+		},
+	})
+}
+
+// Acceptance test for preserving the indentation of the input.
+func TestJSONPrettyPrintFunction_PreserveIndentation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_3spaces" {
+					value = provider::prettyjson::jsonprettyprint("{\n   \"a\": {\"b\":1},\n   \"c\": [\n      1,\n      2\n   ]\n}", "preserve")
+				}
+				output "test_4spaces" {
+					value = provider::prettyjson::jsonprettyprint("{\n    \"a\": [\n        true\n    ], \"b\": null\n}", { indentation_type = "preserve", indentation_fallback = "tab" })
+				}
+				output "test_tab" {
+					value = provider::prettyjson::jsonprettyprint("{\n\t\"a\": {\n\t\t\"b\": [1,2]\n\t}\n}", "preserve")
+				}
+				output "test_minified" {
+					value = provider::prettyjson::jsonprettyprint("{\"a\":[1]}", "preserve")
+				}
+				output "test_minified_fallback" {
+					value = provider::prettyjson::jsonprettyprint("{\"a\":[1]}", { indentation_type = "preserve", indentation_fallback = "4spaces" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_3spaces", "{\n   \"a\": {\n      \"b\": 1\n   },\n   \"c\": [\n      1,\n      2\n   ]\n}"),
+					resource.TestCheckOutput("test_4spaces", "{\n    \"a\": [\n        true\n    ],\n    \"b\": null\n}"),
+					resource.TestCheckOutput("test_tab", "{\n\t\"a\": {\n\t\t\"b\": [\n\t\t\t1,\n\t\t\t2\n\t\t]\n\t}\n}"),
+					resource.TestCheckOutput("test_minified", "{\n  \"a\": [\n    1\n  ]\n}"),
+					resource.TestCheckOutput("test_minified_fallback", "{\n    \"a\": [\n        1\n    ]\n}"),
+				),
+			},
+			{
+				Config: `
+				output "test_invalid_fallback" {
+					value = provider::prettyjson::jsonprettyprint("{}", { indentation_type = "preserve", indentation_fallback = "preserve" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+"preserve"\s+for\s+option\s+"indentation_fallback"`),
+			},
+		},
+	})
+}
+
+// Acceptance test for input that is already pretty-printed or nearly so.
+func TestJSONPrettyPrintFunction_FormattedInput(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_formatted" {
+					value = provider::prettyjson::jsonprettyprint("{\n    \"a\": [\n        1.5,\n        \"x\"\n    ],\n    \"b\": {}\n}", "4spaces")
+				}
+				output "test_other_indentation" {
+					value = provider::prettyjson::jsonprettyprint("{\n    \"a\": 1\n}", "2spaces")
+				}
+				output "test_unsorted_keys" {
+					value = provider::prettyjson::jsonprettyprint("{\n  \"b\": 1,\n  \"a\": 2\n}")
+				}
+				output "test_numbers" {
+					value = provider::prettyjson::jsonprettyprint("[\n  1.0,\n  1e3,\n  0.0000001\n]")
+				}
+				output "test_html" {
+					value = provider::prettyjson::jsonprettyprint("[\n  \"<b>\"\n]")
+				}
+				output "test_trailing_newline" {
+					value = provider::prettyjson::jsonprettyprint("[\n  true\n]\n")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_formatted", "{\n    \"a\": [\n        1.5,\n        \"x\"\n    ],\n    \"b\": {}\n}"),
+					resource.TestCheckOutput("test_other_indentation", "{\n  \"a\": 1\n}"),
+					resource.TestCheckOutput("test_unsorted_keys", "{\n  \"a\": 2,\n  \"b\": 1\n}"),
+					resource.TestCheckOutput("test_numbers", "[\n  1,\n  1000,\n  1e-7\n]"),
+					resource.TestCheckOutput("test_html", "[\n  \"\\u003cb\\u003e\"\n]"),
+					resource.TestCheckOutput("test_trailing_newline", "[\n  true\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Benchmark for formatting the large test fixtures, minified and already
+// pretty-printed.
+func BenchmarkJSONPrettyPrintFunction_Run(b *testing.B) {
+	fixtures := map[string]string{
+		"medium": generateMediumJSON(),
+		"large":  generateLargeJSON(),
+	}
+	for _, name := range []string{"medium", "large"} {
+		var document any
+		if err := json.Unmarshal([]byte(fixtures[name]), &document); err != nil {
+			b.Fatal(err)
+		}
+		minified, _ := json.Marshal(document)
+		formatted, _ := json.MarshalIndent(document, "", "  ")
+
+		for _, input := range []struct {
+			name string
+			json string
+		}{
+			{"minified", string(minified)},
+			{"formatted", string(formatted)},
+		} {
+			b.Run(name+"/"+input.name, func(b *testing.B) {
+				ctx := context.Background()
+				f := NewJSONPrettyPrintFunction()
+				b.SetBytes(int64(len(input.json)))
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					req := function.RunRequest{
+						Arguments: function.NewArgumentsData([]attr.Value{
+							types.StringValue(input.json),
+							types.TupleValueMust(
+								[]attr.Type{types.DynamicType},
+								[]attr.Value{types.DynamicValue(types.StringValue("2spaces"))},
+							),
+						}),
+					}
+					resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+					f.Run(ctx, req, resp)
+					if resp.Error != nil {
+						b.Fatal(resp.Error)
+					}
+				}
+			})
+		}
+	}
+}
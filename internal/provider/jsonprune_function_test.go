@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for pruning switches and exclusions.
+func TestJSONPruneFunction_Prune(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"name\":\"web\",\"description\":null,\"labels\":{},\"tags\":{},\"ports\":[80,null,{}],\"env\":{\"A\":\"\",\"B\":null},\"nested\":{\"list\":[[],{\"x\":null}]},\"zero\":0,\"off\":false}"
+				}
+				output "test_defaults" {
+					value = provider::prettyjson::jsonprune(local.doc)
+				}
+				output "test_switches" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonprune(local.doc, { nulls = false, empty_arrays = false, empty_strings = true })))
+				}
+				output "test_exclude" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonprune(local.doc, { empty_strings = true, exclude = ["tags", "/env/B"] })))
+				}
+				output "test_root" {
+					value = provider::prettyjson::jsonprune("{\"a\":[null,{}]}", { indentation_type = "tab" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_defaults", "{\n  \"name\": \"web\",\n  \"ports\": [\n    80\n  ],\n  \"env\": {\n    \"A\": \"\"\n  },\n  \"zero\": 0,\n  \"off\": false\n}"),
+					resource.TestCheckOutput("test_switches", `{"description":null,"env":{"B":null},"name":"web","nested":{"list":[[],{"x":null}]},"off":false,"ports":[80,null],"zero":0}`),
+					resource.TestCheckOutput("test_exclude", `{"env":{"B":null},"name":"web","off":false,"ports":[80],"tags":{},"zero":0}`),
+					resource.TestCheckOutput("test_root", "{}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONPruneFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_switch" {
+					value = provider::prettyjson::jsonprune("{}", { nulls = "yes" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Option\s+"nulls"\s+must\s+be\s+a\s+boolean,\s+got\s+string`),
+			},
+			{
+				Config: `
+				output "test_invalid_exclude" {
+					value = provider::prettyjson::jsonprune("{}", { exclude = ["a[x]"] })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+option\s+"exclude":\s+path\s+at\s+index\s+0`),
+			},
+		},
+	})
+}
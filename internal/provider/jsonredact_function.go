@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONRedactFunction{}
+)
+
+// Redaction modes supported by jsonredact.
+const (
+	RedactModeMask        = "mask"
+	RedactModePlaceholder = "placeholder"
+	RedactModeHash        = "hash"
+)
+
+// DefaultRedactMask replaces redacted values in mask mode.
+const DefaultRedactMask = "***"
+
+func NewJSONRedactFunction() function.Function {
+	return JSONRedactFunction{}
+}
+
+type JSONRedactFunction struct{}
+
+func (r JSONRedactFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonredact")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonredact"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONRedactFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonredact")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Redact sensitive values in a JSON document",
+		MarkdownDescription: `Replaces sensitive values in a JSON document and returns the pretty-printed result, so sanitized copies of configuration can be written to outputs and artifacts.
+
+## Rules
+
+The ` + "`rules`" + ` object selects the values to redact. All three kinds of rules can be combined:
+
+- ` + "`pointers`" + ` - JSON Pointers ([RFC 6901](https://www.rfc-editor.org/rfc/rfc6901)), for example ` + "`\"/database/password\"`" + `
+- ` + "`paths`" + ` - JSONPath expressions ([RFC 9535](https://www.rfc-editor.org/rfc/rfc9535)), for example ` + "`\"$..credentials\"`" + ` or ` + "`\"$.users[*].api_key\"`" + `
+- ` + "`keys`" + ` - Regular expressions matched against object member names anywhere in the document, for example ` + "`\"(?i)password|secret|token\"`" + `. The expressions are not anchored, so ` + "`token`" + ` also matches ` + "`github_token`" + `.
+
+A rule that matches nothing is not an error, so the same rules can be applied to documents of different shapes. When a matched value is an object or array, the whole value is redacted.
+
+## Modes
+
+- **mask** (default): the value is replaced with the ` + "`mask`" + ` string (default ` + "`\"***\"`" + `)
+- **placeholder**: the value is replaced with a value of the same type, so schemas still validate: strings with the mask, numbers with ` + "`0`" + `, booleans with ` + "`false`" + `, objects with ` + "`{}`" + ` and arrays with ` + "`[]`" + `
+- **hash**: the value is replaced with ` + "`\"sha256:<hex>\"`" + ` of the string, or of the compact JSON text for other values, optionally prefixed with ` + "`salt`" + `. Equal secrets produce equal hashes, so changes remain visible without revealing values.
+
+Key order and number literals of the rest of the document are preserved.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to redact.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.DynamicParameter{
+				Name:                "rules",
+				MarkdownDescription: "Object with lists of rules: `pointers` (JSON Pointers), `paths` (JSONPath expressions) and `keys` (regular expressions for member names).\n\n**Example:** `{ keys = [\"(?i)password|secret|token\"], pointers = [\"/database/host\"] }`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `mode` - `\"mask\"` (default), `\"placeholder\"` or `\"hash\"`\n- `mask` - Replacement string for `mask` and `placeholder` modes (default `\"***\"`)\n- `salt` - String prepended to values before hashing in `hash` mode\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ mode = \"hash\", salt = var.redaction_salt }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONRedactFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonredact")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON redact function execution")
+
+	var documentString string
+	var rulesArgument types.Dynamic
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &rulesArgument, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 2, "mode", "mask", "salt", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	redactor := jsonRedactor{}
+	redactor.mode, funcErr = opts.stringOption("mode", RedactModeMask, RedactModeMask, RedactModePlaceholder, RedactModeHash)
+	if funcErr == nil {
+		redactor.mask, funcErr = opts.stringOption("mask", DefaultRedactMask)
+	}
+	if funcErr == nil {
+		redactor.salt, funcErr = opts.stringOption("salt", "")
+	}
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	rules, funcErr := parseRedactRules(ctx, rulesArgument, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	locations := rules.locations(document)
+	for _, location := range locations {
+		value, err := location.Resolve(document)
+		if err == nil {
+			document, err = pointerReplace(document, location, redactor.redact(value))
+		}
+		if err != nil {
+			tflog.Error(ctx, "JSON redact failed", map[string]any{
+				"error_type": ErrorTypeProcessing,
+				"error_code": "JSON_REDACT_FAILED",
+				"location":   location.String(),
+				"error":      err.Error(),
+			})
+			resp.Error = function.NewFuncError(fmt.Sprintf("Cannot redact value: JSON Pointer %s.", err))
+			return
+		}
+	}
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON redact function execution successful", map[string]any{
+		"result_size":    len(result),
+		"mode":           redactor.mode,
+		"redacted_count": len(locations),
+	})
+}
+
+// jsonRedactRules holds the parsed rules of a jsonredact call.
+type jsonRedactRules struct {
+	pointers []jsonPointer
+	paths    []*jsonPathQuery
+	keys     []*regexp.Regexp
+}
+
+// parseRedactRules decodes and validates the rules argument. Invalid rules
+// are reported with the list and index they appear at.
+func parseRedactRules(ctx context.Context, argument types.Dynamic, argumentPosition int64) (jsonRedactRules, *function.FuncError) {
+	var rules jsonRedactRules
+	allowed := []string{"pointers", "paths", "keys"}
+
+	decoded, err := terraformValueToJSON(ctx, argument)
+	if err != nil {
+		return rules, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid rules: %v.", err))
+	}
+	object, ok := decoded.(*jsonObject)
+	if !ok {
+		return rules, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+			"Rules must be an object, got %s.", jsonTypeName(decoded)))
+	}
+
+	for _, kind := range object.Keys() {
+		if !slices.Contains(allowed, kind) {
+			return rules, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+				"Unknown rule type %q. Valid rule types are: %s.", kind, strings.Join(allowed, ", ")))
+		}
+		value, _ := object.Get(kind)
+		if value == nil {
+			continue
+		}
+		list, ok := stringList(value)
+		if !ok {
+			return rules, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+				"Rules %q must be a list of strings, got %s.", kind, jsonTypeName(value)))
+		}
+
+		for i, rule := range list {
+			var err error
+			switch kind {
+			case "pointers":
+				var pointer jsonPointer
+				if pointer, err = parseJSONPointer(rule); err == nil {
+					rules.pointers = append(rules.pointers, pointer)
+				}
+			case "paths":
+				var query *jsonPathQuery
+				if query, err = parseJSONPath(rule); err == nil {
+					rules.paths = append(rules.paths, query)
+				} else {
+					err = fmt.Errorf("invalid JSONPath expression %q: %w", rule, err)
+				}
+			case "keys":
+				var pattern *regexp.Regexp
+				if pattern, err = regexp.Compile(rule); err == nil {
+					rules.keys = append(rules.keys, pattern)
+				}
+			}
+			if err != nil {
+				tflog.Error(ctx, "Invalid redaction rule", map[string]any{
+					"error_type": ErrorTypeValidation,
+					"error_code": "INVALID_REDACT_RULE",
+					"rule_type":  kind,
+					"rule_index": i,
+					"error":      err.Error(),
+				})
+				return rules, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf(
+					"Invalid rule %s[%d]: %v.", kind, i, err))
+			}
+		}
+	}
+	return rules, nil
+}
+
+// locations returns the locations of all values matched by the rules, with
+// values nested inside another matched value left out. Shorter pointers come
+// first.
+func (r jsonRedactRules) locations(document any) []jsonPointer {
+	var matched []jsonPointer
+	for _, pointer := range r.pointers {
+		if _, err := pointer.Resolve(document); err == nil {
+			matched = append(matched, pointer)
+		}
+	}
+	for _, query := range r.paths {
+		for _, node := range query.Select(document) {
+			matched = append(matched, node.location)
+		}
+	}
+	if len(r.keys) > 0 {
+		matched = r.appendKeyMatches(matched, document, jsonPointer{})
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return len(matched[i]) < len(matched[j])
+	})
+	var locations []jsonPointer
+	for _, candidate := range matched {
+		covered := false
+		for _, location := range locations {
+			if location.IsPrefixOf(candidate) || slices.Equal(location, candidate) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			locations = append(locations, candidate)
+		}
+	}
+	return locations
+}
+
+// appendKeyMatches appends the locations of object members whose names match
+// a key rule. Matched members are not searched further.
+func (r jsonRedactRules) appendKeyMatches(matched []jsonPointer, value any, location jsonPointer) []jsonPointer {
+	switch v := value.(type) {
+	case *jsonObject:
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			if slices.ContainsFunc(r.keys, func(pattern *regexp.Regexp) bool { return pattern.MatchString(key) }) {
+				matched = append(matched, location.child(key))
+				continue
+			}
+			matched = r.appendKeyMatches(matched, member, location.child(key))
+		}
+	case []any:
+		for i, element := range v {
+			matched = r.appendKeyMatches(matched, element, location.child(fmt.Sprint(i)))
+		}
+	}
+	return matched
+}
+
+// jsonRedactor computes replacement values.
+type jsonRedactor struct {
+	mode string
+	mask string
+	salt string
+}
+
+func (r jsonRedactor) redact(value any) any {
+	switch r.mode {
+	case RedactModePlaceholder:
+		switch value.(type) {
+		case string:
+			return r.mask
+		case json.Number:
+			return json.Number("0")
+		case bool:
+			return false
+		case *jsonObject:
+			return newJSONObject()
+		case []any:
+			return []any{}
+		default:
+			return nil
+		}
+	case RedactModeHash:
+		text, ok := value.(string)
+		if !ok {
+			encoded, _ := json.Marshal(value)
+			text = string(encoded)
+		}
+		sum := sha256.Sum256([]byte(r.salt + text))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	default:
+		return r.mask
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for the three kinds of rules.
+func TestJSONRedactFunction_Rules(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"db\":{\"host\":\"db.internal\",\"password\":\"hunter2\"},\"users\":[{\"name\":\"a\",\"api_key\":\"k1\"},{\"name\":\"b\",\"api_key\":\"k2\"}],\"GitHub_Token\":\"ghp\",\"credentials\":{\"user\":\"x\",\"secret\":\"y\"}}"
+				}
+				output "test_pointers" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonredact(local.doc, { pointers = ["/db/host", "/missing"] })).db)
+				}
+				output "test_paths" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonredact(local.doc, { paths = ["$.users[*].api_key"] })).users)
+				}
+				output "test_keys" {
+					value = provider::prettyjson::jsonredact(local.doc, { keys = ["(?i)password|secret|token"] })
+				}
+				output "test_nested_match" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonredact(local.doc, { paths = ["$.credentials"], keys = ["secret"] })).credentials)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_pointers", `{"host":"***","password":"hunter2"}`),
+					resource.TestCheckOutput("test_paths", `[{"api_key":"***","name":"a"},{"api_key":"***","name":"b"}]`),
+					resource.TestCheckOutput("test_keys", "{\n  \"db\": {\n    \"host\": \"db.internal\",\n    \"password\": \"***\"\n  },\n  \"users\": [\n    {\n      \"name\": \"a\",\n      \"api_key\": \"k1\"\n    },\n    {\n      \"name\": \"b\",\n      \"api_key\": \"k2\"\n    }\n  ],\n  \"GitHub_Token\": \"***\",\n  \"credentials\": {\n    \"user\": \"x\",\n    \"secret\": \"***\"\n  }\n}"),
+					resource.TestCheckOutput("test_nested_match", `"***"`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for redaction modes.
+func TestJSONRedactFunction_Modes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc   = "{\"s\":\"secret\",\"n\":42,\"b\":true,\"z\":null,\"o\":{\"a\":1},\"l\":[1]}"
+					rules = { pointers = ["/s", "/n", "/b", "/z", "/o", "/l"] }
+				}
+				output "test_custom_mask" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonredact(local.doc, { pointers = ["/s", "/o"] }, { mask = "[REDACTED]" })))
+				}
+				output "test_placeholder" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonredact(local.doc, local.rules, { mode = "placeholder" })))
+				}
+				output "test_hash" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonredact(local.doc, { pointers = ["/s", "/o"] }, { mode = "hash" })))
+				}
+				output "test_salted_hash" {
+					value = jsondecode(provider::prettyjson::jsonredact(local.doc, { pointers = ["/s"] }, { mode = "hash", salt = "salt" })).s
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_custom_mask", `{"b":true,"l":[1],"n":42,"o":"[REDACTED]","s":"[REDACTED]","z":null}`),
+					resource.TestCheckOutput("test_placeholder", `{"b":false,"l":[],"n":0,"o":{},"s":"***","z":null}`),
+					resource.TestCheckOutput("test_hash", `{"b":true,"l":[1],"n":42,"o":"sha256:015abd7f5cc57a2dd94b7590f04ad8084273905ee33ec5cebeae62276a97f862","s":"sha256:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b","z":null}`),
+					resource.TestCheckOutput("test_salted_hash", "sha256:bede90386d450cea8b77b822f8887065e4e5abf132c2f9dccfcc7fbd4cba5e35"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONRedactFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_regex" {
+					value = provider::prettyjson::jsonredact("{}", { keys = ["(unclosed"] })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+rule\s+keys\[0\]`),
+			},
+			{
+				Config: `
+				output "test_invalid_jsonpath" {
+					value = provider::prettyjson::jsonredact("{}", { paths = ["$.a", "$["] })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+rule\s+paths\[1\]:\s+invalid\s+JSONPath\s+expression\s+"\$\[":\s+at\s+position`),
+			},
+			{
+				Config: `
+				output "test_unknown_rule_type" {
+					value = provider::prettyjson::jsonredact("{}", { regexes = ["x"] })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Unknown\s+rule\s+type\s+"regexes"`),
+			},
+			{
+				Config: `
+				output "test_invalid_mode" {
+					value = provider::prettyjson::jsonredact("{}", { keys = ["x"] }, { mode = "erase" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+"erase"\s+for\s+option\s+"mode"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONReplaceFunction{}
+)
+
+func NewJSONReplaceFunction() function.Function {
+	return JSONReplaceFunction{}
+}
+
+type JSONReplaceFunction struct{}
+
+func (r JSONReplaceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonreplace")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonreplace"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONReplaceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonreplace")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Replace text in JSON string values selected by path",
+		MarkdownDescription: `Rewrites JSON string values at the selected paths with a regular expression and returns the pretty-printed document. Only string values change; keys, numbers and the structure of the document are never touched, so escapes cannot be corrupted the way ` + "`replace()`" + ` on the raw JSON text can.
+
+## Path Selector
+
+The selector is a JSONPath expression ([RFC 9535](https://www.rfc-editor.org/rfc/rfc9535)) when it starts with ` + "`$`" + `, a JSON Pointer ([RFC 6901](https://www.rfc-editor.org/rfc/rfc6901)) when it is empty or starts with ` + "`/`" + `, and a glob-style path as in ` + "`jsonpick`" + ` otherwise. When a selected value is an object or array, all strings within it are rewritten, so ` + "`\"$\"`" + ` rewrites every string value in the document.
+
+## Replacement
+
+The regular expression uses [RE2 syntax](https://github.com/google/re2/wiki/Syntax) and replaces all matches within each string. The replacement can refer to capture groups as ` + "`$1`" + ` or by name as ` + "`$name`" + `. In Terraform strings, ` + "`${1}`" + ` must be written as ` + "`$${1}`" + `, which is needed when a group is followed by a letter, digit or underscore.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to rewrite.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "path_selector",
+				MarkdownDescription: "JSONPath expression, JSON Pointer or glob-style path of the values to rewrite.\n\n**Example:** `\"$..Resource\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "regex",
+				MarkdownDescription: "The regular expression to match within string values.\n\n**Example:** `\"arn:aws:iam::[0-9]{12}:\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "replacement",
+				MarkdownDescription: "The replacement text. `$1` or `$name` insert capture groups.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional indentation type or options object.\n\n**Valid Indentation Types:**\n- `\"2spaces\"` (default) - Two-space indentation\n- `\"4spaces\"` - Four-space indentation\n- `\"tab\"` - Tab character indentation\n\n**Options Object:**\n- `indentation_type` - One of the indentation types above\n\n**Example:**\n`{ indentation_type = \"tab\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONReplaceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonreplace")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON replace function execution")
+
+	var documentString string
+	var selector string
+	var expression string
+	var replacement string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &selector, &expression, &replacement, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	indent, funcErr := parseIndentationOptionsArgument(ctx, options, 4)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	regex, err := regexp.Compile(expression)
+	if err != nil {
+		tflog.Error(ctx, "Invalid regular expression", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "INVALID_REGEX",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid regular expression: %v.", err))
+		return
+	}
+
+	var query *jsonPathQuery
+	var patterns jsonPathPatterns
+	if strings.HasPrefix(selector, "$") {
+		query, funcErr = parseJSONPathArgument(ctx, selector, 1)
+		if funcErr != nil {
+			resp.Error = funcErr
+			return
+		}
+	} else {
+		pattern, err := parseJSONPathPattern(selector)
+		if err != nil {
+			tflog.Error(ctx, "Invalid path selector", map[string]any{
+				"error_type": ErrorTypeValidation,
+				"error_code": "INVALID_PATH_PATTERN",
+				"error":      err.Error(),
+			})
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid path selector: %v.", err))
+			return
+		}
+		patterns = jsonPathPatterns{pattern}
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	replacer := jsonStringReplacer{regex: regex, replacement: replacement, patterns: patterns}
+	if query != nil {
+		replacer.selectNodes(query.Select(document))
+	}
+
+	document = replacer.rewrite(document, jsonPointer{}, false)
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON replace function execution successful", map[string]any{
+		"result_size":       len(result),
+		"selected_count":    len(patterns) + len(replacer.locations),
+		"rewritten_strings": replacer.rewritten,
+	})
+}
+
+// jsonStringReplacer rewrites string values at and below selected locations.
+// Locations are selected by path patterns, or by the nodes of a JSONPath
+// query, which are kept in sets keyed by their JSON Pointer so that each
+// value is checked once rather than against every node.
+type jsonStringReplacer struct {
+	regex       *regexp.Regexp
+	replacement string
+	patterns    jsonPathPatterns
+	// locations holds the selected nodes of a query and ancestors the
+	// locations above them.
+	locations map[string]bool
+	ancestors map[string]bool
+	rewritten int
+}
+
+// selectNodes selects the locations of the nodes of a JSONPath query.
+func (r *jsonStringReplacer) selectNodes(nodes []jsonPathNode) {
+	r.locations = make(map[string]bool, len(nodes))
+	r.ancestors = map[string]bool{}
+	for _, node := range nodes {
+		r.locations[node.location.String()] = true
+		for i := len(node.location) - 1; i >= 0; i-- {
+			ancestor := node.location[:i].String()
+			if r.ancestors[ancestor] {
+				break
+			}
+			r.ancestors[ancestor] = true
+		}
+	}
+}
+
+func (r *jsonStringReplacer) rewrite(value any, location jsonPointer, selected bool) any {
+	if !selected {
+		if r.locations != nil {
+			pointer := location.String()
+			selected = r.locations[pointer]
+			if !selected && !r.ancestors[pointer] {
+				return value
+			}
+		} else {
+			selected = r.patterns.Matches(location)
+			if !selected && !r.patterns.MayMatchBelow(location) {
+				return value
+			}
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if !selected {
+			return v
+		}
+		rewritten := r.regex.ReplaceAllString(v, r.replacement)
+		if rewritten != v {
+			r.rewritten++
+		}
+		return rewritten
+	case *jsonObject:
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			v.Set(key, r.rewrite(member, location.child(key), selected))
+		}
+		return v
+	case []any:
+		for i, element := range v {
+			v[i] = r.rewrite(element, location.child(strconv.Itoa(i)), selected)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for path selectors.
+func TestJSONReplaceFunction_Selectors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Resource\":[\"arn:aws:s3:::bucket-111111111111\",\"arn:aws:iam::111111111111:role/app\"]},{\"Effect\":\"Allow\",\"Resource\":\"arn:aws:iam::111111111111:role/ci\",\"Sid\":\"111111111111\"}],\"arn:aws:iam::111111111111:root\":\"111111111111\"}"
+				}
+				output "test_jsonpath" {
+					value = provider::prettyjson::jsonreplace(local.policy, "$..Resource", "111111111111", "222222222222")
+				}
+				output "test_pointer" {
+					value = jsondecode(provider::prettyjson::jsonreplace(local.policy, "/Statement/1/Sid", "^(\\d{4})\\d+$", "$1-redacted")).Statement[1].Sid
+				}
+				output "test_glob" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonreplace(local.policy, "Statement[*].Effect", "Allow", "Deny")).Statement[*].Effect)
+				}
+				output "test_whole_document_keeps_keys" {
+					value = jsonencode(keys(jsondecode(provider::prettyjson::jsonreplace(local.policy, "$", "111111111111", "x"))))
+				}
+				output "test_named_group" {
+					value = jsondecode(provider::prettyjson::jsonreplace("{\"host\":\"db.eu-west-1.example.com\"}", "host", "^(?P<name>\\w+)\\.(?P<region>[a-z0-9-]+)\\.", "$${name}-primary.$${region}.")).host
+				}
+				output "test_non_string_values_untouched" {
+					value = provider::prettyjson::jsonreplace("{\"a\":1,\"b\":true,\"c\":\"1\"}", "$", "1", "one", "tab")
+				}
+				output "test_options" {
+					value = provider::prettyjson::jsonreplace("{\"c\":\"1\"}", "c", "1", "one", { indentation_type = "4spaces" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_jsonpath", "{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": [\n    {\n      \"Effect\": \"Allow\",\n      \"Resource\": [\n        \"arn:aws:s3:::bucket-222222222222\",\n        \"arn:aws:iam::222222222222:role/app\"\n      ]\n    },\n    {\n      \"Effect\": \"Allow\",\n      \"Resource\": \"arn:aws:iam::222222222222:role/ci\",\n      \"Sid\": \"111111111111\"\n    }\n  ],\n  \"arn:aws:iam::111111111111:root\": \"111111111111\"\n}"),
+					resource.TestCheckOutput("test_pointer", "1111-redacted"),
+					resource.TestCheckOutput("test_glob", `["Deny","Deny"]`),
+					resource.TestCheckOutput("test_whole_document_keeps_keys", `["Statement","Version","arn:aws:iam::111111111111:root"]`),
+					resource.TestCheckOutput("test_named_group", "db-primary.eu-west-1.example.com"),
+					resource.TestCheckOutput("test_non_string_values_untouched", "{\n\t\"a\": 1,\n\t\"b\": true,\n\t\"c\": \"one\"\n}"),
+					resource.TestCheckOutput("test_options", "{\n    \"c\": \"one\"\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONReplaceFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_regex" {
+					value = provider::prettyjson::jsonreplace("{}", "$", "(", "x")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"regex"\s+parameter:\s+Invalid\s+regular\s+expression`),
+			},
+			{
+				Config: `
+				output "test_invalid_jsonpath" {
+					value = provider::prettyjson::jsonreplace("{}", "$[", "a", "b")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSONPath\s+expression\s+at\s+position`),
+			},
+			{
+				Config: `
+				output "test_invalid_glob" {
+					value = provider::prettyjson::jsonreplace("{}", "a]", "a", "b")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+path\s+selector:\s+glob\s+"a\]"\s+has\s+an\s+unexpected\s+']'`),
+			},
+		},
+	})
+}
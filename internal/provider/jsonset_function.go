@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONSetFunction{}
+)
+
+func NewJSONSetFunction() function.Function {
+	return JSONSetFunction{}
+}
+
+type JSONSetFunction struct{}
+
+func (r JSONSetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonset")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonset"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONSetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonset")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Set a value in a JSON document by JSON Pointer",
+		MarkdownDescription: `Sets the value a JSON Pointer ([RFC 6901](https://www.rfc-editor.org/rfc/rfc6901)) refers to and returns the pretty-printed document.
+
+## Overview
+
+Only the addressed value changes, so a single field of a vendor-supplied document can be patched without a ` + "`jsondecode()`" + `/` + "`jsonencode()`" + ` round trip. Key order and number literals of the rest of the document are preserved.
+
+- **Object members** are created, or replaced when they already exist. New members are appended.
+- **Array elements** are replaced. The ` + "`-`" + ` token appends a new element to the end of the array.
+- **The empty pointer** ` + "`\"\"`" + ` replaces the whole document.
+
+## Missing Parents
+
+By default every container above the target must already exist, and a missing one is reported together with the deepest prefix of the pointer that did resolve. With ` + "`create_parents = true`" + ` missing containers are created instead: an array when the next token is ` + "`-`" + `, otherwise an object.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to modify.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "pointer",
+				MarkdownDescription: "The JSON Pointer of the value to set. Use `-` as the last token to append to an array.\n\n**Example:** `\"/spec/replicas\"`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "value_json",
+				MarkdownDescription: "The new value as JSON text, for example `\"3\"`, `\"\\\"eu-west-1\\\"\"` or `jsonencode({ cpu = \"2\" })`.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `create_parents` - Create missing parent containers (default `false`)\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ create_parents = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONSetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonset")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON set function execution")
+
+	var documentString string
+	var pointerString string
+	var valueString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &pointerString, &valueString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 3, "create_parents", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	createParents, funcErr := opts.boolOption("create_parents", false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	pointer, funcErr := parseJSONPointerArgument(ctx, pointerString, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	value, funcErr := parseJSONArgument(ctx, valueString, 2)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	updated, err := pointerSet(document, pointer, value, createParents)
+	if err != nil {
+		tflog.Error(ctx, "JSON set failed", map[string]any{
+			"error_type":     ErrorTypeProcessing,
+			"error_code":     "JSON_SET_FAILED",
+			"pointer":        pointerString,
+			"create_parents": createParents,
+			"error":          err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Cannot set value: JSON Pointer %s.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, updated, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON set function execution successful", map[string]any{
+		"result_size": len(result),
+		"pointer":     pointerString,
+	})
+}
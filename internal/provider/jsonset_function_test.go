@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for setting object members and array elements.
+func TestJSONSetFunction_Basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					doc = "{\"zeta\":1,\"alpha\":{\"price\":1.10,\"big\":12345678901234567890},\"items\":[\"a\",\"b\"]}"
+				}
+				output "test_replace_member" {
+					value = provider::prettyjson::jsonset(local.doc, "/zeta", "2")
+				}
+				output "test_add_member" {
+					value = provider::prettyjson::jsonset("{\"b\":1,\"a\":2}", "/c", "{\"d\":true}")
+				}
+				output "test_replace_element" {
+					value = provider::prettyjson::jsonset("[1,2,3]", "/1", "\"two\"")
+				}
+				output "test_append" {
+					value = provider::prettyjson::jsonset("{\"items\":[\"a\"]}", "/items/-", "\"b\"")
+				}
+				output "test_whole_document" {
+					value = provider::prettyjson::jsonset("{\"a\":1}", "", "[]")
+				}
+				output "test_preserves_numbers" {
+					value = provider::prettyjson::jsonset(local.doc, "/items/0", "\"z\"", { indentation_type = "tab" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_replace_member", "{\n  \"zeta\": 2,\n  \"alpha\": {\n    \"price\": 1.10,\n    \"big\": 12345678901234567890\n  },\n  \"items\": [\n    \"a\",\n    \"b\"\n  ]\n}"),
+					resource.TestCheckOutput("test_add_member", "{\n  \"b\": 1,\n  \"a\": 2,\n  \"c\": {\n    \"d\": true\n  }\n}"),
+					resource.TestCheckOutput("test_replace_element", "[\n  1,\n  \"two\",\n  3\n]"),
+					resource.TestCheckOutput("test_append", "{\n  \"items\": [\n    \"a\",\n    \"b\"\n  ]\n}"),
+					resource.TestCheckOutput("test_whole_document", "[]"),
+					resource.TestCheckOutput("test_preserves_numbers", "{\n\t\"zeta\": 1,\n\t\"alpha\": {\n\t\t\"price\": 1.10,\n\t\t\"big\": 12345678901234567890\n\t},\n\t\"items\": [\n\t\t\"z\",\n\t\t\"b\"\n\t]\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for creating missing parent containers.
+func TestJSONSetFunction_CreateParents(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_nested_objects" {
+					value = provider::prettyjson::jsonset("{}", "/metadata/labels/app", "\"api\"", { create_parents = true })
+				}
+				output "test_nested_array" {
+					value = provider::prettyjson::jsonset("{}", "/spec/ports/-", "80", { create_parents = true })
+				}
+				output "test_append_object" {
+					value = provider::prettyjson::jsonset("{\"rules\":[]}", "/rules/-/name", "\"allow\"", { create_parents = true })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_nested_objects", "{\n  \"metadata\": {\n    \"labels\": {\n      \"app\": \"api\"\n    }\n  }\n}"),
+					resource.TestCheckOutput("test_nested_array", "{\n  \"spec\": {\n    \"ports\": [\n      80\n    ]\n  }\n}"),
+					resource.TestCheckOutput("test_append_object", "{\n  \"rules\": [\n    {\n      \"name\": \"allow\"\n    }\n  ]\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONSetFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_missing_parent" {
+					value = provider::prettyjson::jsonset("{\"metadata\":{}}", "/metadata/labels/app", "\"api\"")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`member\s+"labels"\s+does\s+not\s+exist\s+\(deepest\s+resolved\s+prefix:\s+"/metadata"\)`),
+			},
+			{
+				Config: `
+				output "test_index_out_of_bounds" {
+					value = provider::prettyjson::jsonset("[1]", "/3", "2")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+index\s+3\s+is\s+out\s+of\s+bounds`),
+			},
+			{
+				Config: `
+				output "test_scalar_parent" {
+					value = provider::prettyjson::jsonset("{\"a\":\"x\"}", "/a/b", "1", { create_parents = true })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`cannot\s+descend\s+into\s+string`),
+			},
+			{
+				Config: `
+				output "test_invalid_value" {
+					value = provider::prettyjson::jsonset("{}", "/a", "not json")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+for\s+"value_json"\s+parameter`),
+			},
+			{
+				Config: `
+				output "test_invalid_option" {
+					value = provider::prettyjson::jsonset("{}", "/a", "1", { create_parents = "yes" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Option\s+"create_parents"\s+must\s+be\s+a\s+boolean`),
+			},
+		},
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONSortArraysFunction{}
+)
+
+func NewJSONSortArraysFunction() function.Function {
+	return JSONSortArraysFunction{}
+}
+
+type JSONSortArraysFunction struct{}
+
+func (r JSONSortArraysFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonsortarrays")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonsortarrays"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONSortArraysFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonsortarrays")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Sort arrays at selected paths of a JSON document",
+		MarkdownDescription: `Sorts the arrays at the selected paths of a JSON document and returns the pretty-printed result. APIs often return lists such as policy actions, security group rules or tags in a different order on every read, which produces noisy diffs even after pretty-printing.
+
+` + arraySortRulesDescription + `
+
+Key order and number literals of the document are preserved.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document whose arrays to sort.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.DynamicParameter{
+				Name:                "rules",
+				MarkdownDescription: "A sort rule or a list of sort rules. Each rule is a path, or an object with `path`, `by` and `order`.\n\n**Example:** `[\"Statement[*].Action\", { path = \"rules\", by = [\"protocol\", { key = \"from_port\", order = \"desc\" }] }]`",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ indentation_type = \"4spaces\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONSortArraysFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonsortarrays")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON sort arrays function execution")
+
+	var documentString string
+	var rulesArgument types.Dynamic
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &rulesArgument, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 2, "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	rules, funcErr := parseArraySortRulesArgument(ctx, rulesArgument, 1)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	rules.sort(document, jsonPointer{})
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON sort arrays function execution successful", map[string]any{
+		"result_size": len(result),
+		"rule_count":  len(rules),
+	})
+}
+
+// parseArraySortRulesArgument decodes and validates a sort rules argument.
+func parseArraySortRulesArgument(ctx context.Context, argument types.Dynamic, argumentPosition int64) (jsonArraySortRules, *function.FuncError) {
+	decoded, err := terraformValueToJSON(ctx, argument)
+	if err == nil {
+		var rules jsonArraySortRules
+		if rules, err = parseArraySortRules(decoded); err == nil {
+			return rules, nil
+		}
+	}
+
+	tflog.Error(ctx, "Invalid sort rules", map[string]any{
+		"error_type": ErrorTypeValidation,
+		"error_code": "INVALID_SORT_RULES",
+		"error":      err.Error(),
+	})
+	return nil, function.NewArgumentFuncError(argumentPosition, fmt.Sprintf("Invalid sort rules: %v.", err))
+}
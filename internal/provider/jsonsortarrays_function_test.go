@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for sorting arrays of scalars.
+func TestJSONSortArraysFunction_Scalars(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Action\":[\"s3:PutObject\",\"s3:GetObject\"],\"Resource\":[\"b\",\"a\"]},{\"Action\":[\"sqs:SendMessage\",\"sqs:DeleteMessage\"]}]}"
+				}
+				output "test_glob" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonsortarrays(local.policy, "Statement[*].Action")))
+				}
+				output "test_pointer_descending" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonsortarrays(local.policy, [{ path = "/Statement/0/Resource", order = "desc" }])).Statement[0])
+				}
+				output "test_mixed_types" {
+					value = provider::prettyjson::jsonsortarrays("[\"b\",10,true,null,2.5,\"a\",false,[1],{\"k\":1},1e1,9]", ["**"], { indentation_type = "tab" })
+				}
+				output "test_nested_first" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonsortarrays("[[3,1],[2,9],[1,5]]", "**")))
+				}
+				output "test_key_order" {
+					value = provider::prettyjson::jsonsortarrays("{\"z\":[2,1],\"a\":1.50}", "z")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_glob", `{"Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Resource":["b","a"]},{"Action":["sqs:DeleteMessage","sqs:SendMessage"]}],"Version":"2012-10-17"}`),
+					resource.TestCheckOutput("test_pointer_descending", `{"Action":["s3:PutObject","s3:GetObject"],"Resource":["b","a"]}`),
+					resource.TestCheckOutput("test_mixed_types", "[\n\tnull,\n\tfalse,\n\ttrue,\n\t2.5,\n\t9,\n\t10,\n\t1e1,\n\t\"a\",\n\t\"b\",\n\t[\n\t\t1\n\t],\n\t{\n\t\t\"k\": 1\n\t}\n]"),
+					resource.TestCheckOutput("test_nested_first", `[[1,3],[1,5],[2,9]]`),
+					resource.TestCheckOutput("test_key_order", "{\n  \"z\": [\n    1,\n    2\n  ],\n  \"a\": 1.50\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for sorting arrays of objects by keys.
+func TestJSONSortArraysFunction_ObjectKeys(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					rules = jsonencode({
+						ingress = [
+							{ id = "a", protocol = "tcp", port = 443 },
+							{ id = "b", protocol = "udp", port = 53 },
+							{ id = "c", protocol = "tcp", port = 22 },
+							{ id = "d", port = 80 },
+							{ id = "e", protocol = "tcp", port = 443 },
+							{ id = "f", protocol = "tcp", port = 8443 },
+						]
+					})
+				}
+				output "test_single_key_stable" {
+					value = join(",", [for r in jsondecode(provider::prettyjson::jsonsortarrays(local.rules, { path = "ingress", by = "protocol" })).ingress : r.id])
+				}
+				output "test_composite_key" {
+					value = join(",", [for r in jsondecode(provider::prettyjson::jsonsortarrays(local.rules, { path = "ingress", by = ["/protocol", { key = "port", order = "desc" }] })).ingress : r.id])
+				}
+				output "test_descending" {
+					value = join(",", [for r in jsondecode(provider::prettyjson::jsonsortarrays(local.rules, { path = "ingress", by = "port", order = "desc" })).ingress : r.id])
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_single_key_stable", "a,c,e,f,b,d"),
+					resource.TestCheckOutput("test_composite_key", "f,a,e,c,b,d"),
+					resource.TestCheckOutput("test_descending", "f,a,e,d,b,c"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONSortArraysFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_order" {
+					value = provider::prettyjson::jsonsortarrays("[]", [{ path = "a" }, { path = "b", order = "down" }])
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+sort\s+rules:\s+rule\s+at\s+index\s+1:\s+field\s+"order"\s+must\s+be\s+"asc"\s+or\s+"desc",\s+got\s+"down"`),
+			},
+			{
+				Config: `
+				output "test_missing_path" {
+					value = provider::prettyjson::jsonsortarrays("[]", { by = "name" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`field\s+"path"\s+is\s+required`),
+			},
+			{
+				Config: `
+				output "test_invalid_sort_key" {
+					value = provider::prettyjson::jsonsortarrays("[]", { path = "", by = [1] })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`field\s+"by"\s+at\s+index\s+0:\s+sort\s+key\s+must\s+be\s+a\s+string\s+or\s+an\s+object,\s+got\s+number`),
+			},
+		},
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToCSVFunction{}
+)
+
+func NewJSONToCSVFunction() function.Function {
+	return JSONToCSVFunction{}
+}
+
+type JSONToCSVFunction struct{}
+
+func (r JSONToCSVFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontocsv")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontocsv"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToCSVFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontocsv")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert a JSON array of objects to CSV",
+		MarkdownDescription: `Converts a JSON array of objects to a CSV document with a header row and one row per object.
+
+## Columns
+
+By default, the columns are the members of all objects in order of first appearance, and a cell is empty when its object lacks the member or holds ` + "`null`" + `. ` + "`columns`" + ` selects the columns and their order instead; members that are not listed are left out. Without columns, as for an empty array without ` + "`columns`" + `, the result is an empty string.
+
+Nested objects and arrays are flattened into separate columns as in ` + "`jsonflatten`" + `, so ` + "`{\"name\":\"web\",\"tags\":{\"env\":\"prod\"},\"ports\":[80,443]}`" + ` has the columns ` + "`name`" + `, ` + "`tags.env`" + `, ` + "`ports[0]`" + ` and ` + "`ports[1]`" + `. ` + "`separator`" + ` changes the separator between keys. With ` + "`flatten = false`" + `, nested values are written as compact JSON text in a single column.
+
+## Quoting
+
+Fields that contain the delimiter, the quote character, line breaks or leading or trailing spaces are quoted, and quotes inside them are doubled, as described in [RFC 4180](https://www.rfc-editor.org/rfc/rfc4180). Rows end with LF. Strings are written as they are, and numbers and booleans as their JSON text.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "array",
+				MarkdownDescription: "The JSON array of objects to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Must be an array of objects\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `columns` - Columns to write, in order; flattened paths such as `\"tags.env\"` select nested values\n- `header` - Write a header row (default `true`)\n- `flatten` - Flatten nested objects and arrays into separate columns (default `true`)\n- `separator` - Separator between keys of flattened columns (default `\".\"`)\n- `delimiter` - Field delimiter (default `\",\"`)\n- `quote` - Quote character (default `\"\\\"\"`), or `\"\"` to disable quoting\n\n**Example:**\n`{ columns = [\"hostname\", \"ip\", \"tags.env\"] }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToCSVFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontocsv")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to CSV function execution")
+
+	var arrayString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arrayString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "columns", "header", "flatten", "separator", "delimiter", "quote")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	writer := csvWriter{}
+	if writer.csvDialect, funcErr = parseCSVDialect(opts); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if writer.columns, funcErr = opts.stringListOption("columns"); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if writer.header, funcErr = opts.boolOption("header", true); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if writer.flatten, funcErr = opts.boolOption("flatten", true); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if writer.separator, funcErr = opts.stringOption("separator", "."); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if err := validateFlattenSeparator(writer.separator); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid option \"separator\": %v.", err))
+		return
+	}
+
+	rows, funcErr := parseJSONArrayArgument(ctx, arrayString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := writer.format(rows)
+	if err != nil {
+		tflog.Error(ctx, "CSV formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "CSV_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert to CSV: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to CSV function execution successful", map[string]any{
+		"result_size": len(result),
+		"row_count":   len(rows),
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to CSV.
+func TestJSONToCSVFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					hosts = "[{\"name\":\"web\",\"tags\":{\"env\":\"prod\"},\"ports\":[80,443],\"note\":\"a, \\\"b\\\"\"},{\"name\":\" db\",\"enabled\":true,\"tags\":{},\"note\":null,\"ports\":[5432]}]"
+				}
+				output "test_default" {
+					value = provider::prettyjson::jsontocsv(local.hosts)
+				}
+				output "test_columns" {
+					value = provider::prettyjson::jsontocsv(local.hosts, { columns = ["tags/env", "name", "missing"], separator = "/", delimiter = ";" })
+				}
+				output "test_no_flatten" {
+					value = provider::prettyjson::jsontocsv(local.hosts, { flatten = false, header = false, quote = "'" })
+				}
+				output "test_empty" {
+					value = provider::prettyjson::jsontocsv("[]")
+				}
+				output "test_empty_columns" {
+					value = provider::prettyjson::jsontocsv("[]", { columns = ["a", "b"] })
+				}
+				output "test_html" {
+					value = provider::prettyjson::jsontocsv("[{\"links\":[\"<a href='x'>\",\"&\"]}]", { flatten = false })
+				}
+				output "test_round_trip" {
+					value = jsonencode(jsondecode(provider::prettyjson::csvtojson(provider::prettyjson::jsontocsv(local.hosts), { infer_types = true })))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", "name,tags.env,ports[0],ports[1],note,enabled,tags\nweb,prod,80,443,\"a, \"\"b\"\"\",,\n\" db\",,5432,,,true,{}\n"),
+					resource.TestCheckOutput("test_columns", "tags/env;name;missing\nprod;web;\n;\" db\";\n"),
+					resource.TestCheckOutput("test_no_flatten", `web,{"env":"prod"},'[80,443]','a, "b"',
+' db',{},[5432],,true
+`),
+					resource.TestCheckOutput("test_empty", ""),
+					resource.TestCheckOutput("test_empty_columns", "a,b\n"),
+					resource.TestCheckOutput("test_html", "links\n\"[\"\"<a href='x'>\"\",\"\"&\"\"]\"\n"),
+					resource.TestCheckOutput("test_round_trip", `[{"enabled":null,"name":"web","note":"a, \"b\"","ports[0]":80,"ports[1]":443,"tags":null,"tags.env":"prod"},{"enabled":true,"name":" db","note":null,"ports[0]":5432,"ports[1]":null,"tags":"{}","tags.env":null}]`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToCSVFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_not_array" {
+					value = provider::prettyjson::jsontocsv("{\"a\":1}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Document\s+must\s+be\s+a\s+JSON\s+array,\s+got\s+object`),
+			},
+			{
+				Config: `
+				output "test_not_objects" {
+					value = provider::prettyjson::jsontocsv("[{\"a\":1},2]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`element\s+at\s+index\s+1\s+must\s+be\s+an\s+object,\s+got\s+number`),
+			},
+			{
+				Config: `
+				output "test_unquotable" {
+					value = provider::prettyjson::jsontocsv("[{\"a\":\"x,y\"}]", { quote = "" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`element\s+at\s+index\s+0\s+contains\s+the\s+delimiter\s+or\s+a\s+line\s+break`),
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToEnvFunction{}
+)
+
+func NewJSONToEnvFunction() function.Function {
+	return JSONToEnvFunction{}
+}
+
+type JSONToEnvFunction struct{}
+
+func (r JSONToEnvFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoenv")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontoenv"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToEnvFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoenv")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert a JSON object to a dotenv file",
+		MarkdownDescription: `Converts a JSON object to a dotenv file with one ` + "`NAME=value`" + ` line per value, as read by Docker Compose, ` + "`docker run --env-file`" + ` for plain values, and dotenv libraries.
+
+## Names
+
+Nested keys are joined with the separator, ` + "`\"_\"`" + ` by default, and array elements are numbered: ` + "`{\"db\":{\"hosts\":[\"a\",\"b\"]}}`" + ` becomes ` + "`db_hosts_0=a`" + ` and ` + "`db_hosts_1=b`" + `. With ` + "`arrays = \"reject\"`" + `, arrays are rejected instead. ` + "`prefix`" + ` is prepended to every name and ` + "`uppercase`" + ` converts names to upper case. Names must consist of letters, digits and underscores and must not start with a digit; other names, and keys that map to the same name, are rejected with their JSON Pointer.
+
+## Values
+
+- Values made of letters, digits and ` + "`_ . / : @ % + , = -`" + ` are written as they are.
+- Other values without single quotes or line breaks are enclosed in single quotes, so that ` + "`$`" + ` and backslashes are taken literally.
+- Remaining values are enclosed in double quotes, with ` + "`\\`" + `, ` + "`\"`" + `, ` + "`$`" + ` and backquotes escaped by a backslash and line breaks and tabs written as ` + "`\\n`" + `, ` + "`\\r`" + ` and ` + "`\\t`" + `.
+
+` + "`null`" + ` becomes an empty value, and numbers and booleans their JSON text.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON object to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Must be an object\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `separator` - Separator between nested keys (default `\"_\"`)\n- `arrays` - `\"index\"` (default) to write array elements as indexed keys, or `\"reject\"` to reject arrays\n- `prefix` - Prefix of every variable name\n- `uppercase` - Convert names to upper case (default `false`)\n- `export` - Start every line with `export ` so that the file can be sourced by a shell (default `false`)\n\n**Example:**\n`{ prefix = \"APP_\", uppercase = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToEnvFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoenv")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to dotenv function execution")
+
+	var documentString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "separator", "arrays", "prefix", "uppercase", "export")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	formatter := envFormatter{}
+	if formatter.keyValueFlattener, funcErr = parseKeyValueFlattener(opts, "_", true); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if formatter.prefix, funcErr = opts.stringOption("prefix", ""); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if formatter.uppercase, funcErr = opts.boolOption("uppercase", false); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if formatter.export, funcErr = opts.boolOption("export", false); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := formatter.format(document)
+	if err != nil {
+		tflog.Error(ctx, "dotenv formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "ENV_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert to dotenv: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to dotenv function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to dotenv.
+func TestJSONToEnvFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					settings = jsonencode({
+						db       = { host = "db.internal", hosts = ["a", "b"] }
+						empty    = null
+						flag     = true
+						greeting = "hello $USER"
+						motd     = "it's\n\"up\""
+						path     = "/usr/bin"
+					})
+				}
+				output "test_default" {
+					value = provider::prettyjson::jsontoenv(local.settings)
+				}
+				output "test_options" {
+					value = provider::prettyjson::jsontoenv("{\"db\":{\"port\":5432},\"tags\":[\"x\"]}", { prefix = "app__", separator = "__", uppercase = true, export = true })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", `db_host=db.internal
+db_hosts_0=a
+db_hosts_1=b
+empty=
+flag=true
+greeting='hello $USER'
+motd="it's\n\"up\""
+path=/usr/bin
+`),
+					resource.TestCheckOutput("test_options", "export APP__DB__PORT=5432\nexport APP__TAGS__0=x\n"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToEnvFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_not_object" {
+					value = provider::prettyjson::jsontoenv("[1]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`document\s+must\s+be\s+a\s+JSON\s+object,\s+got\s+array`),
+			},
+			{
+				Config: `
+				output "test_reject" {
+					value = provider::prettyjson::jsontoenv("{\"db\":{\"hosts\":[\"a\"]}}", { arrays = "reject" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+at\s+"/db/hosts"\s+is\s+not\s+allowed`),
+			},
+			{
+				Config: `
+				output "test_invalid_name" {
+					value = provider::prettyjson::jsontoenv("{\"my-key\":1}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`"my-key"\s+at\s+"/my-key"\s+is\s+not\s+a\s+valid\s+environment\s+variable\s+name`),
+			},
+			{
+				Config: `
+				output "test_duplicate" {
+					value = provider::prettyjson::jsontoenv("{\"a\":{\"b\":1},\"A_B\":2}", { uppercase = true })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`keys\s+at\s+"/a/b"\s+and\s+"/A_B"\s+both\s+map\s+to\s+the\s+variable\s+"A_B"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToHCLFunction{}
+)
+
+func NewJSONToHCLFunction() function.Function {
+	return JSONToHCLFunction{}
+}
+
+type JSONToHCLFunction struct{}
+
+func (r JSONToHCLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontohcl")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontohcl"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToHCLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontohcl")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert a JSON object to HCL attributes",
+		MarkdownDescription: `Converts a JSON object to HCL attributes, such as a ` + "`.tfvars`" + ` file or Terragrunt ` + "`inputs`" + `, formatted as ` + "`terraform fmt`" + ` would.
+
+## Conversion
+
+- Members of the document become attributes in document order, with their ` + "`=`" + ` signs aligned. Their names must be valid HCL identifiers.
+- Objects are written over several lines. Keys that are not identifiers, and the keys ` + "`null`" + `, ` + "`true`" + ` and ` + "`false`" + `, are quoted.
+- Arrays of scalars are written on one line, such as ` + "`[80, 443]`" + `, and other arrays with one element per line.
+- Strings are quoted, with ` + "`${`" + ` and ` + "`%{`" + ` escaped as ` + "`$${`" + ` and ` + "`%%{`" + ` so that they are not read as templates.
+
+## Heredocs
+
+Multi-line strings that end with a line break are written as heredocs, except inside arrays:
+
+` + "```hcl" + `
+motd = <<-EOT
+  Welcome to
+  the server
+EOT
+` + "```" + `
+
+Strings whose lines are all indented use ` + "`<<EOT`" + ` to keep their indentation. Set ` + "`heredocs = false`" + ` to quote all strings.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON object to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Must be an object\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `heredocs` - Write multi-line strings as heredocs (default `true`)\n\n**Example:**\n`{ heredocs = false }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToHCLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontohcl")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to HCL function execution")
+
+	var documentString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "heredocs")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	heredocs, funcErr := opts.boolOption("heredocs", true)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := formatHCLDocument(document, heredocs)
+	if err != nil {
+		tflog.Error(ctx, "HCL formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "HCL_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert to HCL: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to HCL function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
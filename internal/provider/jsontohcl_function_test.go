@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to HCL.
+func TestJSONToHCLFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					inputs = "{\"region\":\"eu-west-1\",\"instance_count\":3,\"enabled\":true,\"tags\":{\"Name\":\"web\",\"cost-center\":\"42\",\"null\":null},\"ports\":[80,443],\"rules\":[{\"port\":22,\"cidrs\":[]}],\"motd\":\"Welcome to\\nthe \\\"server\\\"\\n\",\"empty\":{}}"
+				}
+				output "test_default" {
+					value = provider::prettyjson::jsontohcl(local.inputs)
+				}
+				output "test_no_heredocs" {
+					value = provider::prettyjson::jsontohcl("{\"motd\":\"a\\nb\\n\"}", { heredocs = false })
+				}
+				output "test_round_trip" {
+					value = provider::prettyjson::hcltojson(provider::prettyjson::jsontohcl(local.inputs))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", `region         = "eu-west-1"
+instance_count = 3
+enabled        = true
+tags = {
+  Name        = "web"
+  cost-center = "42"
+  "null"      = null
+}
+ports = [80, 443]
+rules = [
+  {
+    port  = 22
+    cidrs = []
+  },
+]
+motd  = <<-EOT
+  Welcome to
+  the "server"
+EOT
+empty = {}
+`),
+					resource.TestCheckOutput("test_no_heredocs", "motd = \"a\\nb\\n\"\n"),
+					resource.TestCheckOutput("test_round_trip", `{
+  "region": "eu-west-1",
+  "instance_count": 3,
+  "enabled": true,
+  "tags": {
+    "Name": "web",
+    "cost-center": "42",
+    "null": null
+  },
+  "ports": [
+    80,
+    443
+  ],
+  "rules": [
+    {
+      "port": 22,
+      "cidrs": []
+    }
+  ],
+  "motd": "Welcome to\nthe \"server\"\n",
+  "empty": {}
+}`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToHCLFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_not_object" {
+					value = provider::prettyjson::jsontohcl("[1]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`document\s+must\s+be\s+a\s+JSON\s+object,\s+got\s+array`),
+			},
+			{
+				Config: `
+				output "test_invalid_name" {
+					value = provider::prettyjson::jsontohcl("{\"1st\":1}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`key\s+"1st"\s+at\s+"/1st"\s+is\s+not\s+a\s+valid\s+HCL\s+attribute\s+name`),
+			},
+		},
+	})
+}
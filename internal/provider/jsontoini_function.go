@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToINIFunction{}
+)
+
+func NewJSONToINIFunction() function.Function {
+	return JSONToINIFunction{}
+}
+
+type JSONToINIFunction struct{}
+
+func (r JSONToINIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoini")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontoini"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToINIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoini")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert a JSON object to an INI file",
+		MarkdownDescription: `Converts a JSON object to an INI file.
+
+## Sections
+
+Members of the document whose values are objects become sections, and the other members become keys before the first section. Objects nested inside sections are flattened into keys joined with the separator, ` + "`\".\"`" + ` by default, and array elements are written as indexed keys such as ` + "`hosts[0]`" + `, or rejected with ` + "`arrays = \"reject\"`" + `:
+
+` + "```ini" + `
+name = billing
+
+[database]
+host = db.internal
+pool.size = 10
+` + "```" + `
+
+## Values
+
+Keys are written as ` + "`key = value`" + `. Values are enclosed in double quotes when they are empty, have leading or trailing spaces, or contain quotes, ` + "`;`" + `, ` + "`#`" + `, backslashes or line breaks; inside quotes, ` + "`\\`" + ` and ` + "`\"`" + ` are escaped with a backslash and line breaks and tabs are written as ` + "`\\n`" + `, ` + "`\\r`" + ` and ` + "`\\t`" + `, as in Git configuration files. ` + "`null`" + ` becomes an empty value.
+
+Keys and section names that contain characters with a special meaning in INI files, such as ` + "`=`" + `, ` + "`[`" + ` or line breaks, are rejected with their JSON Pointer.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON object to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Must be an object\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `separator` - Separator between nested keys (default `\".\"`)\n- `arrays` - `\"index\"` (default) to write array elements as indexed keys, or `\"reject\"` to reject arrays\n\n**Example:**\n`{ separator = \"_\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToINIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoini")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to INI function execution")
+
+	var documentString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "separator", "arrays")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	formatter := iniFormatter{}
+	if formatter.keyValueFlattener, funcErr = parseKeyValueFlattener(opts, ".", false); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := formatter.format(document)
+	if err != nil {
+		tflog.Error(ctx, "INI formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "INI_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert to INI: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to INI function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
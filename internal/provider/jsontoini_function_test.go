@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to INI.
+func TestJSONToINIFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					config = "{\"database\":{\"host\":\"db.internal\",\"pool\":{\"size\":10},\"replicas\":[\"r1\",\"r2\"]},\"name\":\"billing\",\"server\":{\"banner\":\" Welcome; \\\"guest\\\"\\n\",\"password\":\"\",\"debug\":null},\"tags\":[\"a\"]}"
+				}
+				output "test_default" {
+					value = provider::prettyjson::jsontoini(local.config)
+				}
+				output "test_separator" {
+					value = provider::prettyjson::jsontoini("{\"app\":{\"log\":{\"level\":\"info\"}}}", { separator = "_" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", `name = billing
+tags[0] = a
+
+[database]
+host = db.internal
+pool.size = 10
+replicas[0] = r1
+replicas[1] = r2
+
+[server]
+banner = " Welcome; \"guest\"\n"
+password = ""
+debug = ""
+`),
+					resource.TestCheckOutput("test_separator", "[app]\nlog_level = info\n"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToINIFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_reject" {
+					value = provider::prettyjson::jsontoini("{\"s\":{\"hosts\":[\"a\"]}}", { arrays = "reject" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+at\s+"/s/hosts"\s+is\s+not\s+allowed`),
+			},
+			{
+				Config: `
+				output "test_invalid_key" {
+					value = provider::prettyjson::jsontoini("{\"s\":{\"a=b\":1}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`key\s+"a=b"\s+at\s+"/s/a=b"\s+cannot\s+be\s+written\s+in\s+INI`),
+			},
+			{
+				Config: `
+				output "test_invalid_section" {
+					value = provider::prettyjson::jsontoini("{\"[x]\":{}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`section\s+name\s+"\[x\]"\s+at\s+"/\[x\]"\s+cannot\s+be\s+written\s+in\s+INI`),
+			},
+			{
+				Config: `
+				output "test_duplicate" {
+					value = provider::prettyjson::jsontoini("{\"x\":{\"a.b\":1,\"a\":{\"b\":2}}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`keys\s+at\s+"/x/a.b"\s+and\s+"/x/a/b"\s+both\s+map\s+to\s+the\s+key\s+"a.b"`),
+			},
+		},
+	})
+}
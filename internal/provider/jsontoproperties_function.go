@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToPropertiesFunction{}
+)
+
+func NewJSONToPropertiesFunction() function.Function {
+	return JSONToPropertiesFunction{}
+}
+
+type JSONToPropertiesFunction struct{}
+
+func (r JSONToPropertiesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoproperties")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontoproperties"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToPropertiesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoproperties")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert a JSON object to a Java properties file",
+		MarkdownDescription: `Converts a JSON object to a Java properties file with one ` + "`key=value`" + ` line per value.
+
+Nested keys are joined with the separator, ` + "`\".\"`" + ` by default, and array elements are written as indexed keys, as used by Spring Boot: ` + "`{\"spring\":{\"profiles\":[\"a\",\"b\"]}}`" + ` becomes ` + "`spring.profiles[0]=a`" + ` and ` + "`spring.profiles[1]=b`" + `. With ` + "`arrays = \"reject\"`" + `, arrays are rejected instead.
+
+## Escaping
+
+Keys and values are escaped as ` + "`java.util.Properties.store`" + ` does: backslashes, ` + "`=`" + `, ` + "`:`" + `, ` + "`#`" + ` and ` + "`!`" + ` are escaped with a backslash, line breaks, tabs and form feeds are written as ` + "`\\n`" + `, ` + "`\\r`" + `, ` + "`\\t`" + ` and ` + "`\\f`" + `, and spaces are escaped in keys and at the start of values. By default, characters outside printable ASCII are written as ` + "`\\uXXXX`" + ` escapes, so the file can be loaded with ` + "`Properties.load(InputStream)`" + `, which reads ISO 8859-1. With ` + "`ascii = false`" + `, they are written as UTF-8. ` + "`null`" + ` becomes an empty value.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON object to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Must be an object\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `separator` - Separator between nested keys (default `\".\"`)\n- `arrays` - `\"index\"` (default) to write array elements as indexed keys, or `\"reject\"` to reject arrays\n- `ascii` - Escape characters outside printable ASCII as `\\uXXXX` (default `true`)\n\n**Example:**\n`{ arrays = \"reject\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToPropertiesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoproperties")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to properties function execution")
+
+	var documentString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "separator", "arrays", "ascii")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	formatter := propertiesFormatter{}
+	if formatter.keyValueFlattener, funcErr = parseKeyValueFlattener(opts, ".", false); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if formatter.ascii, funcErr = opts.boolOption("ascii", true); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := formatter.format(document)
+	if err != nil {
+		tflog.Error(ctx, "properties formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "PROPERTIES_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert to properties: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to properties function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
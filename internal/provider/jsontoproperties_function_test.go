@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to Java properties.
+func TestJSONToPropertiesFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					config = "{\"spring\":{\"profiles\":[\"a\",\"b\"],\"datasource\":{\"url\":\"jdbc:postgresql://db:5432/app\"}},\"greeting\":\" Grüße #1\\n\",\"my key\":\"a=b\",\"emoji\":\"🙂\",\"unset\":null}"
+				}
+				output "test_default" {
+					value = provider::prettyjson::jsontoproperties(local.config)
+				}
+				output "test_utf8" {
+					value = provider::prettyjson::jsontoproperties(local.config, { ascii = false })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", `spring.profiles[0]=a
+spring.profiles[1]=b
+spring.datasource.url=jdbc\:postgresql\://db\:5432/app
+greeting=\ Gr\u00FC\u00DFe \#1\n
+my\ key=a\=b
+emoji=\uD83D\uDE42
+unset=
+`),
+					resource.TestCheckOutput("test_utf8", `spring.profiles[0]=a
+spring.profiles[1]=b
+spring.datasource.url=jdbc\:postgresql\://db\:5432/app
+greeting=\ Grüße \#1\n
+my\ key=a\=b
+emoji=🙂
+unset=
+`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToPropertiesFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_reject" {
+					value = provider::prettyjson::jsontoproperties("{\"a\":[1]}", { arrays = "reject" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+at\s+"/a"\s+is\s+not\s+allowed`),
+			},
+			{
+				Config: `
+				output "test_invalid_option" {
+					value = provider::prettyjson::jsontoproperties("{}", { arrays = "skip" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+"skip"\s+for\s+option\s+"arrays"`),
+			},
+			{
+				Config: `
+				output "test_duplicate" {
+					value = provider::prettyjson::jsontoproperties("{\"a.b\":1,\"a\":{\"b\":2}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`keys\s+at\s+"/a.b"\s+and\s+"/a/b"\s+both\s+map\s+to\s+the\s+key\s+"a.b"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToTOMLFunction{}
+)
+
+func NewJSONToTOMLFunction() function.Function {
+	return JSONToTOMLFunction{}
+}
+
+type JSONToTOMLFunction struct{}
+
+func (r JSONToTOMLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontotoml")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontotoml"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToTOMLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontotoml")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert JSON to TOML",
+		MarkdownDescription: `Converts a JSON object to a TOML document, preserving key order within each table.
+
+## Conversion
+
+- Nested objects become tables (` + "`[server.tls]`" + `) and arrays of objects become arrays of tables (` + "`[[inputs.cpu]]`" + `). Objects nested in other arrays are written as inline tables. A table's plain values are written before its sub-tables, as TOML requires.
+- Numbers without a fraction or exponent become integers and must fit in 64 bits; other numbers become floats.
+- Multi-line strings are written as multi-line basic strings (` + "`\"\"\"`" + `).
+- Keys that are not bare keys are quoted.
+
+TOML has no ` + "`null`" + `, and TOML 0.5 parsers, which are still common, reject arrays with elements of different types. Such values are rejected with the JSON Pointer of the offending value, for example ` + "`null value at \"/agent/hostname\" cannot be represented in TOML`" + `. Use ` + "`jsonprune`" + ` to drop nulls first.
+
+With ` + "`tag_datetimes`" + `, objects of the form ` + "`{\"type\": \"datetime\", \"value\": \"1979-05-27T07:32:00Z\"}`" + `, as produced by ` + "`tomltojson`" + ` with the same option, are written as TOML datetimes. Without it, datetimes are plain strings.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON object to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Must be an object\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `tag_datetimes` - Write objects with a datetime `type` and `value` as TOML datetimes (default `false`)\n\n**Example:**\n`{ tag_datetimes = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToTOMLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontotoml")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to TOML function execution")
+
+	var documentString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "tag_datetimes")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	tagDatetimes, funcErr := opts.boolOption("tag_datetimes", false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := formatTOMLDocument(document, tagDatetimes)
+	if err != nil {
+		tflog.Error(ctx, "TOML formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "TOML_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert to TOML: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to TOML function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to TOML.
+func TestJSONToTOMLFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_tables" {
+					value = provider::prettyjson::jsontotoml("{\"agent\":{\"interval\":\"10s\",\"debug\":false},\"name\":\"web\",\"outputs\":{\"influxdb\":{\"urls\":[\"http://a:8086\"]}},\"ratio\":0.5,\"port\":8080}")
+				}
+				output "test_table_arrays" {
+					value = provider::prettyjson::jsontotoml("{\"inputs\":{\"cpu\":[{\"percpu\":true,\"tags\":{\"role\":\"db\"}},{\"percpu\":false}]},\"matrix\":[[1,2],[{\"a\":\"b\"}]],\"empty\":{}}")
+				}
+				output "test_strings" {
+					value = provider::prettyjson::jsontotoml("{\"script\":\"echo \\\"hi\\\"\\nexit\\n\",\"path\":\"C:\\\\tmp\",\"odd key\":\"\\u0001\",\"list\":[\"a\\nb\"]}")
+				}
+				output "test_round_trip" {
+					value = provider::prettyjson::tomltojson(provider::prettyjson::jsontotoml(provider::prettyjson::tomltojson("when = 1979-05-27T07:32:00Z\n[[a]]\nb = 1\n[a.c]\nd = \"e\\nf\"\n", { tag_datetimes = true }), { tag_datetimes = true }), { tag_datetimes = true })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_tables", "name = \"web\"\nratio = 0.5\nport = 8080\n\n[agent]\ninterval = \"10s\"\ndebug = false\n\n[outputs.influxdb]\nurls = [\"http://a:8086\"]\n"),
+					resource.TestCheckOutput("test_table_arrays", "matrix = [[1, 2], [{ a = \"b\" }]]\n\n[[inputs.cpu]]\npercpu = true\n\n[inputs.cpu.tags]\nrole = \"db\"\n\n[[inputs.cpu]]\npercpu = false\n\n[empty]\n"),
+					resource.TestCheckOutput("test_strings", "script = \"\"\"\necho \\\"hi\\\"\nexit\n\"\"\"\npath = \"C:\\\\tmp\"\n\"odd key\" = \"\\u0001\"\nlist = [\"a\\nb\"]\n"),
+					resource.TestCheckOutput("test_round_trip", "{\n  \"when\": {\n    \"type\": \"datetime\",\n    \"value\": \"1979-05-27T07:32:00Z\"\n  },\n  \"a\": [\n    {\n      \"b\": 1,\n      \"c\": {\n        \"d\": \"e\\nf\"\n      }\n    }\n  ]\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToTOMLFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_null" {
+					value = provider::prettyjson::jsontotoml("{\"agent\":{\"hostname\":null}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`null\s+value\s+at\s+"/agent/hostname"\s+cannot\s+be\s+represented\s+in\s+TOML`),
+			},
+			{
+				Config: `
+				output "test_mixed_array" {
+					value = provider::prettyjson::jsontotoml("{\"servers\":[{\"port\":1},[1,\"a\"]]}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+at\s+"/servers/1"\s+mixes\s+number\s+and\s+string\s+elements`),
+			},
+			{
+				Config: `
+				output "test_tables_and_values" {
+					value = provider::prettyjson::jsontotoml("{\"servers\":[{\"port\":1},\"b\"]}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+at\s+"/servers"\s+mixes\s+table\s+and\s+string\s+elements`),
+			},
+			{
+				Config: `
+				output "test_not_object" {
+					value = provider::prettyjson::jsontotoml("[1]")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`document\s+must\s+be\s+a\s+JSON\s+object,\s+got\s+array`),
+			},
+			{
+				Config: `
+				output "test_big_integer" {
+					value = provider::prettyjson::jsontotoml("{\"n\":99999999999999999999}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`number\s+99999999999999999999\s+at\s+"/n"\s+is\s+out\s+of\s+the\s+range\s+of\s+TOML\s+integers`),
+			},
+		},
+	})
+}
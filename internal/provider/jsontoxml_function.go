@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToXMLFunction{}
+)
+
+func NewJSONToXMLFunction() function.Function {
+	return JSONToXMLFunction{}
+}
+
+type JSONToXMLFunction struct{}
+
+func (r JSONToXMLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoxml")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontoxml"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToXMLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoxml")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert JSON to pretty-printed XML",
+		MarkdownDescription: `Converts a JSON document to an indented, well-formed XML document, preserving key order.
+
+` + xmlConventionsDescription + `
+
+## Output
+
+- The root element is the single member of the document, such as ` + "`{\"config\": {...}}`" + `. With ` + "`root`" + `, the whole document becomes the content of an element with that name instead.
+- Arrays become repeated elements. Arrays directly inside arrays cannot be represented and are rejected.
+- ` + "`null`" + `, empty strings and empty objects become empty elements (` + "`<name/>`" + `). Attributes whose value is ` + "`null`" + ` are omitted.
+- Numbers and booleans are written as text. Special characters are escaped, and element and attribute names must be valid XML names.
+- Namespace prefixes are written as given, for example ` + "`\"soap:Envelope\"`" + `. The ` + "`xmlns`" + ` option declares namespaces on the root element: ` + "`{ \"\" = \"urn:default\", soap = \"http://schemas.xmlsoap.org/soap/envelope/\" }`" + `.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `root` - Name of a root element that wraps the document\n- `attribute_prefix` - Prefix of attribute member names (default `\"@\"`)\n- `text_key` - Member name of element text (default `\"#text\"`)\n- `xmlns` - Namespace URIs by prefix, declared on the root element; `\"\"` is the default namespace\n- `declaration` - Start with an XML declaration (default `true`)\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ root = \"config\", xmlns = { \"\" = \"urn:example:config\" } }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToXMLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoxml")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to XML function execution")
+
+	var documentString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "root", "attribute_prefix", "text_key", "xmlns", "declaration", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	conventions, funcErr := parseXMLConventions(opts)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	root, funcErr := opts.stringOption("root", "")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	xmlns, funcErr := parseXMLNamespacesOption(opts)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	declaration, funcErr := opts.boolOption("declaration", true)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, err := formatXMLDocument(document, conventions, root, xmlns, indent, declaration)
+	if err != nil {
+		tflog.Error(ctx, "XML formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "XML_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert to XML: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to XML function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
+
+// parseXMLNamespacesOption reads the xmlns option as namespace declarations.
+func parseXMLNamespacesOption(opts functionOptions) ([]xmlAttribute, *function.FuncError) {
+	value, ok := opts.values.Get("xmlns")
+	if !ok || value == nil {
+		return nil, nil
+	}
+	object, isObject := value.(*jsonObject)
+	if !isObject {
+		return nil, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf(
+			"Option \"xmlns\" must be an object of namespace URIs by prefix, got %s.", jsonTypeName(value)))
+	}
+
+	var declarations []xmlAttribute
+	for _, prefix := range object.Keys() {
+		member, _ := object.Get(prefix)
+		uri, isString := member.(string)
+		if !isString {
+			return nil, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf(
+				"Option \"xmlns\": namespace URI for prefix %q must be a string, got %s.", prefix, jsonTypeName(member)))
+		}
+		if prefix == "" {
+			declarations = append(declarations, xmlAttribute{"xmlns", uri})
+			continue
+		}
+		if !xmlNamePattern.MatchString(prefix) || strings.Contains(prefix, ":") {
+			return nil, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf(
+				"Option \"xmlns\": invalid namespace prefix %q.", prefix))
+		}
+		declarations = append(declarations, xmlAttribute{"xmlns:" + prefix, uri})
+	}
+	return declarations, nil
+}
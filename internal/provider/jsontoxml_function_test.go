@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to XML.
+func TestJSONToXMLFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_default" {
+					value = provider::prettyjson::jsontoxml("{\"config\":{\"@version\":2,\"name\":\"edge & <core>\",\"server\":[{\"@id\":\"a\",\"@note\":\"say \\\"hi\\\"\\n\",\"port\":[80,443]},{\"@id\":\"b\",\"@skip\":null}],\"note\":{\"@lang\":\"en\",\"#text\":\"short\"},\"enabled\":true,\"empty\":null,\"blank\":\"\"}}")
+				}
+				output "test_root" {
+					value = provider::prettyjson::jsontoxml("{\"_id\":\"x\",\"value\":\"text\",\"item\":[1,2]}", {
+						root             = "soap:Body"
+						attribute_prefix = "_"
+						text_key         = "value"
+						xmlns            = { "" = "urn:default", soap = "http://schemas.xmlsoap.org/soap/envelope/" }
+						declaration      = false
+						indentation_type = "tab"
+					})
+				}
+				output "test_round_trip" {
+					value = jsonencode(jsondecode(provider::prettyjson::xmltojson(provider::prettyjson::jsontoxml("{\"a\":{\"@id\":\"1\",\"b\":[\"x\",\"y\"],\"c\":{\"#text\":\"t\",\"d\":null}}}"))))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", `<?xml version="1.0" encoding="UTF-8"?>
+<config version="2">
+  <name>edge &amp; &lt;core&gt;</name>
+  <server id="a" note="say &quot;hi&quot;&#xA;">
+    <port>80</port>
+    <port>443</port>
+  </server>
+  <server id="b"/>
+  <note lang="en">short</note>
+  <enabled>true</enabled>
+  <empty/>
+  <blank/>
+</config>
+`),
+					resource.TestCheckOutput("test_root", "<soap:Body xmlns=\"urn:default\" xmlns:soap=\"http://schemas.xmlsoap.org/soap/envelope/\" id=\"x\">text\n\t<item>1</item>\n\t<item>2</item>\n</soap:Body>\n"),
+					resource.TestCheckOutput("test_round_trip", `{"a":{"@id":"1","b":["x","y"],"c":{"#text":"t","d":null}}}`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToXMLFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_no_root" {
+					value = provider::prettyjson::jsontoxml("{\"a\":1,\"b\":2}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`must\s+be\s+an\s+object\s+with\s+a\s+single\s+member,\s+the\s+root\s+element`),
+			},
+			{
+				Config: `
+				output "test_invalid_name" {
+					value = provider::prettyjson::jsontoxml("{\"a\":{\"1st\":true}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`invalid\s+element\s+name\s+"1st"\s+at\s+"/a/1st"`),
+			},
+			{
+				Config: `
+				output "test_nested_array" {
+					value = provider::prettyjson::jsontoxml("{\"a\":{\"b\":[[1]]}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`nested\s+array\s+at\s+"/a/b/0"\s+cannot\s+be\s+represented\s+in\s+XML`),
+			},
+			{
+				Config: `
+				output "test_object_attribute" {
+					value = provider::prettyjson::jsontoxml("{\"a\":{\"@b\":{}}}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`object\s+at\s+"/a/@b"\s+must\s+be\s+a\s+string,\s+number\s+or\s+boolean`),
+			},
+			{
+				Config: `
+				output "test_control_character" {
+					value = provider::prettyjson::jsontoxml("{\"a\":\"\\u0001\"}")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`string\s+at\s+"/a"\s+contains\s+the\s+character\s+U\+0001`),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONToYAMLFunction{}
+)
+
+func NewJSONToYAMLFunction() function.Function {
+	return JSONToYAMLFunction{}
+}
+
+type JSONToYAMLFunction struct{}
+
+func (r JSONToYAMLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoyaml")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsontoyaml"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONToYAMLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoyaml")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert JSON to YAML",
+		MarkdownDescription: `Converts a JSON document to YAML, preserving key order.
+
+## Conversion
+
+- Strings that a YAML parser would read as another type, such as ` + "`\"true\"`" + `, ` + "`\"1.0\"`" + ` or the YAML 1.1 booleans ` + "`\"yes\"`" + ` and ` + "`\"no\"`" + `, are quoted. Other strings are written plain where possible, and multi-line strings as literal block scalars (` + "`|`" + `).
+- Numbers keep their JSON literal.
+- Empty objects and arrays are written as ` + "`{}`" + ` and ` + "`[]`" + `.
+
+With ` + "`multi_document`" + `, a top-level array is written as a stream with one document per element, separated by ` + "`---`" + `, as expected by ` + "`kubectl apply`" + ` and similar tools.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document to convert.\n\n**Requirements:**\n- Must be valid JSON syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `indent` - Number of spaces per indentation level, from 2 to 9 (default `2`)\n- `multi_document` - Write the elements of a top-level array as separate documents (default `false`)\n\n**Example:**\n`{ indent = 4, multi_document = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONToYAMLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsontoyaml")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON to YAML function execution")
+
+	var documentString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &documentString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "indent", "multi_document")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.intOption("indent", DefaultYAMLIndent, 2, 9)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	multiDocument, funcErr := opts.boolOption("multi_document", false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, funcErr := parseJSONArgument(ctx, documentString, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	documents := []any{document}
+	if array, ok := document.([]any); ok && multiDocument {
+		documents = array
+	}
+
+	result, err := formatYAMLDocuments(documents, indent)
+	if err != nil {
+		tflog.Error(ctx, "YAML formatting failed", map[string]any{
+			"error_type": ErrorTypeProcessing,
+			"error_code": "YAML_FORMAT_ERROR",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewFuncError(fmt.Sprintf("YAML formatting failed: %v.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON to YAML function execution successful", map[string]any{
+		"result_size":    len(result),
+		"document_count": len(documents),
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting JSON to YAML.
+func TestJSONToYAMLFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_default" {
+					value = provider::prettyjson::jsontoyaml("{\"name\":\"web\",\"ports\":[80,443],\"labels\":{\"app\":\"web\"},\"empty\":{},\"none\":[],\"ratio\":1.50,\"on\":true,\"nothing\":null}")
+				}
+				output "test_quoting" {
+					value = provider::prettyjson::jsontoyaml("[\"no\",\"yes\",\"true\",\"1.0\",\"0o17\",\"\",\"~\",\"1:20\",\"plain text\",\"a: b\"]")
+				}
+				output "test_multiline" {
+					value = provider::prettyjson::jsontoyaml("{\"script\":\"echo one\\necho two\\n\"}")
+				}
+				output "test_indent" {
+					value = provider::prettyjson::jsontoyaml("{\"a\":{\"b\":[{\"c\":1}]}}", { indent = 4 })
+				}
+				output "test_multi_document" {
+					value = provider::prettyjson::jsontoyaml("[{\"kind\":\"A\"},{\"kind\":\"B\"}]", { multi_document = true })
+				}
+				output "test_round_trip" {
+					value = provider::prettyjson::yamltojson(provider::prettyjson::jsontoyaml("{\"z\":\"no\",\"a\":[\"1.0\",1.0,\"\"],\"m\":\"x\\ny\"}"))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", "name: web\nports:\n  - 80\n  - 443\nlabels:\n  app: web\nempty: {}\nnone: []\nratio: 1.50\n\"on\": true\nnothing: null\n"),
+					resource.TestCheckOutput("test_quoting", "- \"no\"\n- \"yes\"\n- \"true\"\n- \"1.0\"\n- \"0o17\"\n- \"\"\n- \"~\"\n- \"1:20\"\n- plain text\n- 'a: b'\n"),
+					resource.TestCheckOutput("test_multiline", "script: |\n  echo one\n  echo two\n"),
+					resource.TestCheckOutput("test_indent", "a:\n    b:\n        - c: 1\n"),
+					resource.TestCheckOutput("test_multi_document", "kind: A\n---\nkind: B\n"),
+					resource.TestCheckOutput("test_round_trip", "{\n  \"z\": \"no\",\n  \"a\": [\n    \"1.0\",\n    1.0,\n    \"\"\n  ],\n  \"m\": \"x\\ny\"\n}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONToYAMLFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_invalid_indent" {
+					value = provider::prettyjson::jsontoyaml("{}", { indent = 1 })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Option\s+"indent"\s+must\s+be\s+a\s+whole\s+number\s+between\s+2\s+and\s+9,\s+got\s+1`),
+			},
+			{
+				Config: `
+				output "test_invalid_json" {
+					value = provider::prettyjson::jsontoyaml("{")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+syntax\s+detected`),
+			},
+		},
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = JSONUnflattenFunction{}
+)
+
+func NewJSONUnflattenFunction() function.Function {
+	return JSONUnflattenFunction{}
+}
+
+type JSONUnflattenFunction struct{}
+
+func (r JSONUnflattenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonunflatten")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "jsonunflatten"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r JSONUnflattenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonunflatten")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Rebuild a JSON document from a map of flattened paths",
+		MarkdownDescription: `Rebuilds a JSON document from a map keyed by flattened paths such as ` + "`a.b[0].c`" + `, reversing ` + "`jsonflatten`" + `, and returns it pretty-printed.
+
+## Overview
+
+Keys are split at the separator into object keys, and bracketed indices such as ` + "`[0]`" + ` create arrays. A backslash escapes the following character within keys, such as the first character of the separator, ` + "`[`" + `, ` + "`]`" + ` or a backslash, as produced by ` + "`jsonflatten`" + `. Objects keep the order of their sorted paths.
+
+## Values
+
+By default every value is a JSON string, which suits sources such as SSM Parameter Store where ` + "`\"1.10\"`" + ` is a version rather than a number. With ` + "`infer_types = true`" + ` values that are JSON numbers, ` + "`true`" + `, ` + "`false`" + `, ` + "`null`" + `, ` + "`{}`" + ` or ` + "`[]`" + ` are decoded, so the output of ` + "`jsonflatten`" + ` round-trips to the original document.
+
+## Errors
+
+Paths that conflict, such as ` + "`db`" + ` and ` + "`db.port`" + `, or arrays with missing indices are reported together with the paths involved.`,
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "map",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of flattened paths to values, for example the result of `jsonflatten`.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+			function.StringParameter{
+				Name:                "separator",
+				MarkdownDescription: "The separator between object keys, for example `\".\"`, `\"/\"` or `\"__\"`. Must not be empty or contain `\\`, `[` or `]`.",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `infer_types` - Decode numbers, booleans, `null` and empty containers instead of keeping strings (default `false`)\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ infer_types = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r JSONUnflattenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "jsonunflatten")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting JSON unflatten function execution")
+
+	var entries map[string]string
+	var separator string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &entries, &separator, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 2, "infer_types", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	inferTypes, funcErr := opts.boolOption("infer_types", false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if err := validateFlattenSeparator(separator); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid separator: %v.", err))
+		return
+	}
+
+	document, err := unflattenJSON(entries, separator, inferTypes)
+	if err != nil {
+		tflog.Error(ctx, "JSON unflatten failed", map[string]any{
+			"error_type": ErrorTypeValidation,
+			"error_code": "JSON_UNFLATTEN_FAILED",
+			"error":      err.Error(),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot unflatten map: %v.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "JSON unflatten function execution successful", map[string]any{
+		"result_size": len(result),
+		"entry_count": len(entries),
+		"infer_types": inferTypes,
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for rebuilding documents.
+func TestJSONUnflattenFunction_Rebuild(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					params = {
+						"app/db/hosts[0]" = "a"
+						"app/db/hosts[1]" = "b"
+						"app/db/port"     = "5432"
+						"app/version"     = "1.10"
+						"app/debug"       = "false"
+					}
+				}
+				output "test_strings" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonunflatten(local.params, "/")))
+				}
+				output "test_infer_types" {
+					value = provider::prettyjson::jsonunflatten(local.params, "/", { infer_types = true, indentation_type = "tab" })
+				}
+				output "test_escaped_keys" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonunflatten({ "example\\.com.ttl" = "60", "a\\[0\\]" = "x" }, ".")))
+				}
+				output "test_root_array" {
+					value = jsonencode(jsondecode(provider::prettyjson::jsonunflatten({ "[0]" = "a", "[1].b" = "c" }, ".")))
+				}
+				output "test_empty" {
+					value = provider::prettyjson::jsonunflatten({}, ".")
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_strings", `{"app":{"db":{"hosts":["a","b"],"port":"5432"},"debug":"false","version":"1.10"}}`),
+					resource.TestCheckOutput("test_infer_types", "{\n\t\"app\": {\n\t\t\"db\": {\n\t\t\t\"hosts\": [\n\t\t\t\t\"a\",\n\t\t\t\t\"b\"\n\t\t\t],\n\t\t\t\"port\": 5432\n\t\t},\n\t\t\"debug\": false,\n\t\t\"version\": 1.10\n\t}\n}"),
+					resource.TestCheckOutput("test_escaped_keys", `{"a[0]":"x","example.com":{"ttl":"60"}}`),
+					resource.TestCheckOutput("test_root_array", `["a",{"b":"c"}]`),
+					resource.TestCheckOutput("test_empty", "{}"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestJSONUnflattenFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_conflict" {
+					value = provider::prettyjson::jsonunflatten({ "db" = "x", "db.port" = "5432" }, ".")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`path\s+"db.port"\s+conflicts\s+with\s+path\s+"db"`),
+			},
+			{
+				Config: `
+				output "test_array_gap" {
+					value = provider::prettyjson::jsonunflatten({ "a[0]" = "x", "a[2]" = "y" }, ".")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`array\s+index\s+1\s+is\s+missing`),
+			},
+			{
+				Config: `
+				output "test_invalid_index" {
+					value = provider::prettyjson::jsonunflatten({ "a[01]" = "x" }, ".")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`invalid\s+array\s+index\s+"01"`),
+			},
+			{
+				Config: `
+				output "test_object_array_conflict" {
+					value = provider::prettyjson::jsonunflatten({ "a[0]" = "x", "a.b" = "y" }, ".")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`conflicts\s+with\s+path`),
+			},
+		},
+	})
+}
@@ -45,7 +45,43 @@ func (p *PrettyJSONProvider) Schema(ctx context.Context, req provider.SchemaRequ
 
 ## Supported Functions
 
-- **jsonprettyprint**: Format JSON strings with configurable indentation (2spaces, 4spaces, or tab)
+- **jsonprettyprint**: Format JSON strings with configurable indentation (2spaces, 4spaces, tab, or preserve)
+- **jsonpatch**: Apply an RFC 6902 JSON Patch to a JSON document
+- **jsonmergepatch**: Apply an RFC 7396 JSON Merge Patch to a JSON document
+- **jsondeepmerge**: Deep merge a list of JSON documents with configurable array strategies
+- **jsonpointer**: Read a value from a JSON document by RFC 6901 JSON Pointer
+- **jsonset**: Set a value in a JSON document by JSON Pointer
+- **jsondelete**: Delete a value from a JSON document by JSON Pointer
+- **jsonpath**: Query a JSON document with an RFC 9535 JSONPath expression
+- **jmespath**: Query a JSON document with a JMESPath expression
+- **jq**: Transform a JSON document with a sandboxed jq program
+- **jsoncel**: Evaluate Common Expression Language (CEL) expressions against a JSON document
+- **jsonflatten**: Flatten a JSON document to a map of dotted or bracketed paths
+- **jsonunflatten**: Rebuild a JSON document from a map of flattened paths
+- **jsonredact**: Redact sensitive values by JSON Pointer, JSONPath or key pattern
+- **jsonpick**: Keep only selected paths of a JSON document
+- **jsonomit**: Remove selected paths from a JSON document
+- **jsonkeycase**: Convert all object keys to camel, pascal, snake, kebab or screaming snake case
+- **jsonreplace**: Replace text in JSON string values selected by path, with capture groups
+- **jsonarraydedupe**: Remove duplicate elements from a JSON array using deep equality
+- **jsonarrayunion**: Union of JSON arrays using deep equality
+- **jsonarrayintersect**: Intersection of JSON arrays using deep equality
+- **jsonarraysubtract**: Remove the elements of one JSON array from another using deep equality
+- **jsonsortarrays**: Sort arrays at selected paths by value or by object keys
+- **jsonprune**: Remove null values, empty objects, empty arrays and empty strings recursively
+- **yamltojson**: Convert YAML to JSON with the YAML 1.2 core schema, preserving key order
+- **jsontoyaml**: Convert JSON to YAML with configurable indentation
+- **tomltojson**: Convert TOML to JSON, preserving key order
+- **jsontotoml**: Convert a JSON object to TOML
+- **xmltojson**: Convert XML to JSON with configurable attribute and text conventions
+- **jsontoxml**: Convert JSON to pretty-printed XML
+- **csvtojson**: Convert CSV to a JSON array of objects with optional type inference
+- **jsontocsv**: Convert a JSON array of objects to CSV with flattened nested fields
+- **jsontoenv**: Convert a JSON object to a dotenv file with flattened, quoted variables
+- **jsontoini**: Convert a JSON object to an INI file with sections and escaped values
+- **jsontoproperties**: Convert a JSON object to a Java properties file with flattened keys
+- **jsontohcl**: Convert a JSON object to HCL attributes for .tfvars files
+- **hcltojson**: Convert literal HCL attributes to JSON
 
 This provider does not manage any infrastructure resources - it only provides utility functions for JSON formatting.`,
 		Attributes: map[string]schema.Attribute{}, // Empty attributes for function-only provider
@@ -67,6 +103,42 @@ func (p *PrettyJSONProvider) DataSources(ctx context.Context) []func() datasourc
 func (p *PrettyJSONProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewJSONPrettyPrintFunction,
+		NewJSONPatchFunction,
+		NewJSONMergePatchFunction,
+		NewJSONDeepMergeFunction,
+		NewJSONPointerFunction,
+		NewJSONSetFunction,
+		NewJSONDeleteFunction,
+		NewJSONPathFunction,
+		NewJMESPathFunction,
+		NewJQFunction,
+		NewJSONCELFunction,
+		NewJSONFlattenFunction,
+		NewJSONUnflattenFunction,
+		NewJSONRedactFunction,
+		NewJSONPickFunction,
+		NewJSONOmitFunction,
+		NewJSONKeyCaseFunction,
+		NewJSONReplaceFunction,
+		NewJSONArrayDedupeFunction,
+		NewJSONArrayUnionFunction,
+		NewJSONArrayIntersectFunction,
+		NewJSONArraySubtractFunction,
+		NewJSONSortArraysFunction,
+		NewJSONPruneFunction,
+		NewYAMLToJSONFunction,
+		NewJSONToYAMLFunction,
+		NewTOMLToJSONFunction,
+		NewJSONToTOMLFunction,
+		NewXMLToJSONFunction,
+		NewJSONToXMLFunction,
+		NewCSVToJSONFunction,
+		NewJSONToCSVFunction,
+		NewJSONToEnvFunction,
+		NewJSONToINIFunction,
+		NewJSONToPropertiesFunction,
+		NewJSONToHCLFunction,
+		NewHCLToJSONFunction,
 	}
 }
 
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// terraformValueToJSON converts a framework value of any type into the
+// ordered document model. Terraform maps and objects carry no member order,
+// so their keys are emitted in lexical order, matching jsonencode().
+func terraformValueToJSON(ctx context.Context, value attr.Value) (any, error) {
+	tfValue, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tftypesValueToJSON(tfValue)
+}
+
+func tftypesValueToJSON(value tftypes.Value) (any, error) {
+	if !value.IsKnown() {
+		return nil, fmt.Errorf("value is not yet known")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+		return s, nil
+	case valueType.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case valueType.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		return bigFloatToJSONNumber(n), nil
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		array := make([]any, 0, len(elements))
+		for _, element := range elements {
+			converted, err := tftypesValueToJSON(element)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, converted)
+		}
+		return array, nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var members map[string]tftypes.Value
+		if err := value.As(&members); err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(members))
+		for key := range members {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		object := newJSONObject()
+		for _, key := range keys {
+			converted, err := tftypesValueToJSON(members[key])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			object.Set(key, converted)
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", valueType)
+	}
+}
+
+// bigFloatToJSONNumber renders a Terraform number as a JSON number literal.
+func bigFloatToJSONNumber(n *big.Float) json.Number {
+	if n.IsInt() {
+		i, _ := n.Int(nil)
+		return json.Number(i.String())
+	}
+	return json.Number(n.Text('g', -1))
+}
+
+// jsonToTerraformValue converts a document value into a framework value
+// suitable for a dynamic function result. Objects become object values,
+// arrays become tuples and null becomes a dynamic null, mirroring the types
+// Terraform's own jsondecode() produces.
+func jsonToTerraformValue(ctx context.Context, value any) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case string:
+		return types.StringValue(v), nil
+	case json.Number:
+		n, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s: %w", v, err)
+		}
+		return types.NumberValue(n), nil
+	case []any:
+		elementTypes := make([]attr.Type, len(v))
+		elements := make([]attr.Value, len(v))
+		for i, element := range v {
+			converted, err := jsonToTerraformValue(ctx, element)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes[i] = converted.Type(ctx)
+			elements[i] = converted
+		}
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building tuple value: %v", diags)
+		}
+		return tuple, nil
+	case *jsonObject:
+		attributeTypes := make(map[string]attr.Type, v.Len())
+		attributes := make(map[string]attr.Value, v.Len())
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			converted, err := jsonToTerraformValue(ctx, member)
+			if err != nil {
+				return nil, err
+			}
+			attributeTypes[key] = converted.Type(ctx)
+			attributes[key] = converted
+		}
+		object, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building object value: %v", diags)
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported document value of type %T", value)
+	}
+}
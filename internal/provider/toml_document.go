@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Types of tagged TOML datetimes, as in the toml-test JSON encoding.
+const (
+	TOMLDatetime      = "datetime"
+	TOMLDatetimeLocal = "datetime-local"
+	TOMLDateLocal     = "date-local"
+	TOMLTimeLocal     = "time-local"
+)
+
+// tomlDatetimeLayouts are the layouts datetimes are written and read with.
+var tomlDatetimeLayouts = map[string]string{
+	TOMLDatetime:      time.RFC3339Nano,
+	TOMLDatetimeLocal: "2006-01-02T15:04:05.999999999",
+	TOMLDateLocal:     "2006-01-02",
+	TOMLTimeLocal:     "15:04:05.999999999",
+}
+
+// tomlBareKeyPattern matches keys that need no quotes.
+var tomlBareKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// parseTOMLDocument decodes a TOML document into the ordered document model.
+// Datetimes become strings, or tagged objects when tagDatetimes is set.
+func parseTOMLDocument(data string, tagDatetimes bool) (*jsonObject, error) {
+	var table map[string]any
+	metadata, err := toml.Decode(data, &table)
+	if err != nil {
+		return nil, err
+	}
+
+	// The decoder returns Go maps; the metadata lists every key in the order
+	// it appears in the document, which restores the member order.
+	converter := tomlConverter{positions: map[string]int{}, tagDatetimes: tagDatetimes}
+	for i, key := range metadata.Keys() {
+		path := strings.Join(key, "\x00")
+		if _, seen := converter.positions[path]; !seen {
+			converter.positions[path] = i
+		}
+	}
+
+	value, err := converter.convert(table, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return value.(*jsonObject), nil
+}
+
+// tomlConverter converts decoded TOML values into document values.
+type tomlConverter struct {
+	positions    map[string]int
+	tagDatetimes bool
+}
+
+// convert converts value. keyPath is the TOML key path, which does not
+// include array indices, and location is the JSON Pointer used in errors.
+func (c tomlConverter) convert(value any, keyPath []string, location jsonPointer) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		return c.convertTable(v, keyPath, location)
+	case []map[string]any:
+		array := make([]any, len(v))
+		for i, table := range v {
+			element, err := c.convertTable(table, keyPath, location.child(strconv.Itoa(i)))
+			if err != nil {
+				return nil, err
+			}
+			array[i] = element
+		}
+		return array, nil
+	case []any:
+		array := make([]any, len(v))
+		for i, element := range v {
+			converted, err := c.convert(element, keyPath, location.child(strconv.Itoa(i)))
+			if err != nil {
+				return nil, err
+			}
+			array[i] = converted
+		}
+		return array, nil
+	case string, bool:
+		return v, nil
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10)), nil
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, fmt.Errorf("value %v at %q cannot be represented in JSON", v, location.String())
+		}
+		// Whole floats keep a fraction so that they stay floats.
+		text := formatJSONFloat(v)
+		if !strings.ContainsAny(text, ".e") {
+			text += ".0"
+		}
+		return json.Number(text), nil
+	case time.Time:
+		return c.convertDatetime(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported value of type %T at %q", value, location.String())
+	}
+}
+
+// convertTable converts a table, ordering its members as in the document.
+func (c tomlConverter) convertTable(table map[string]any, keyPath []string, location jsonPointer) (*jsonObject, error) {
+	position := func(key string) int {
+		if i, ok := c.positions[strings.Join(append(slices.Clip(keyPath), key), "\x00")]; ok {
+			return i
+		}
+		return math.MaxInt
+	}
+	keys := make([]string, 0, len(table))
+	for key := range table {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		if c := position(a) - position(b); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+
+	object := newJSONObject()
+	for _, key := range keys {
+		member, err := c.convert(table[key], append(slices.Clip(keyPath), key), location.child(key))
+		if err != nil {
+			return nil, err
+		}
+		object.Set(key, member)
+	}
+	return object, nil
+}
+
+// convertDatetime formats a datetime as an RFC 3339 string, or its local
+// date or time part for local values.
+func (c tomlConverter) convertDatetime(t time.Time) any {
+	kind := TOMLDatetime
+	switch name := t.Location().String(); name {
+	case TOMLDatetimeLocal, TOMLDateLocal, TOMLTimeLocal:
+		kind = name
+	}
+	text := t.Format(tomlDatetimeLayouts[kind])
+	if !c.tagDatetimes {
+		return text
+	}
+	tagged := newJSONObject()
+	tagged.Set("type", kind)
+	tagged.Set("value", text)
+	return tagged
+}
+
+// tomlWriter writes a document as TOML.
+type tomlWriter struct {
+	b            strings.Builder
+	tagDatetimes bool
+}
+
+// formatTOMLDocument writes a document, which must be an object, as TOML.
+// With tagDatetimes, objects in the tagged form produced by tomltojson are
+// written as datetimes.
+func formatTOMLDocument(document any, tagDatetimes bool) (string, error) {
+	object, ok := document.(*jsonObject)
+	if !ok {
+		return "", fmt.Errorf("document must be a JSON object, got %s", jsonTypeName(document))
+	}
+	w := &tomlWriter{tagDatetimes: tagDatetimes}
+	if err := w.writeTable(object, nil, nil, false); err != nil {
+		return "", err
+	}
+	return w.b.String(), nil
+}
+
+// writeTable writes the members of a table: first its key/value pairs, then
+// its sub-tables and arrays of tables in document order. header is the table's key path, and
+// arrayElement selects the [[header]] form.
+func (w *tomlWriter) writeTable(object *jsonObject, header []string, location jsonPointer, arrayElement bool) error {
+	var values, sections []string
+	for _, key := range object.Keys() {
+		member, _ := object.Get(key)
+		kind, err := w.classify(member, location.child(key))
+		if err != nil {
+			return err
+		}
+		if kind == "table" || kind == "array of tables" {
+			sections = append(sections, key)
+		} else {
+			values = append(values, key)
+		}
+	}
+
+	// A table header is implied by the headers of its sub-tables, so it is
+	// only written when the table has values of its own or nothing at all.
+	if arrayElement || (header != nil && (len(values) > 0 || len(sections) == 0)) {
+		if w.b.Len() > 0 {
+			w.b.WriteByte('\n')
+		}
+		if arrayElement {
+			fmt.Fprintf(&w.b, "[[%s]]\n", tomlKeyPath(header))
+		} else {
+			fmt.Fprintf(&w.b, "[%s]\n", tomlKeyPath(header))
+		}
+	}
+	for _, key := range values {
+		member, _ := object.Get(key)
+		w.b.WriteString(tomlKey(key))
+		w.b.WriteString(" = ")
+		if err := w.writeValue(member, location.child(key), true); err != nil {
+			return err
+		}
+		w.b.WriteByte('\n')
+	}
+
+	for _, key := range sections {
+		member, _ := object.Get(key)
+		path := append(slices.Clip(header), key)
+		if table, ok := member.(*jsonObject); ok {
+			if err := w.writeTable(table, path, location.child(key), false); err != nil {
+				return err
+			}
+			continue
+		}
+		for i, element := range member.([]any) {
+			if err := w.writeTable(element.(*jsonObject), path, location.child(key).child(strconv.Itoa(i)), true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// classify returns the TOML kind of a value and checks that it can be
+// represented: tables, arrays of tables, arrays and scalar kinds.
+func (w *tomlWriter) classify(value any, location jsonPointer) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", fmt.Errorf("null value at %q cannot be represented in TOML", location.String())
+	case *jsonObject:
+		if w.datetimeKind(v) != "" {
+			return "datetime", nil
+		}
+		return "table", nil
+	case []any:
+		var elementKind string
+		for i, element := range v {
+			kind, err := w.classify(element, location.child(strconv.Itoa(i)))
+			if err != nil {
+				return "", err
+			}
+			if kind == "array of tables" {
+				kind = "array"
+			}
+			if elementKind == "" {
+				elementKind = kind
+			} else if kind != elementKind {
+				return "", fmt.Errorf("array at %q mixes %s and %s elements", location.String(), elementKind, kind)
+			}
+		}
+		if elementKind == "table" {
+			return "array of tables", nil
+		}
+		return "array", nil
+	case json.Number:
+		return "number", nil
+	default:
+		return jsonTypeName(value), nil
+	}
+}
+
+// datetimeKind returns the type of a tagged datetime object, or "" if the
+// object is not one or tagging is off.
+func (w *tomlWriter) datetimeKind(object *jsonObject) string {
+	if !w.tagDatetimes || object.Len() != 2 {
+		return ""
+	}
+	kind, _ := object.Get("type")
+	value, _ := object.Get("value")
+	kindString, _ := kind.(string)
+	if _, isString := value.(string); !isString || tomlDatetimeLayouts[kindString] == "" {
+		return ""
+	}
+	return kindString
+}
+
+// writeValue writes a value. Only top-level values of a table may use
+// multi-line strings; values inside inline tables and arrays stay on one line.
+func (w *tomlWriter) writeValue(value any, location jsonPointer, multiline bool) error {
+	switch v := value.(type) {
+	case nil:
+		return fmt.Errorf("null value at %q cannot be represented in TOML", location.String())
+	case bool:
+		w.b.WriteString(strconv.FormatBool(v))
+	case string:
+		if multiline && strings.Contains(v, "\n") {
+			w.b.WriteString(tomlMultilineString(v))
+		} else {
+			w.b.WriteString(tomlString(v))
+		}
+	case json.Number:
+		number, err := tomlNumber(v)
+		if err != nil {
+			return fmt.Errorf("number %s at %q %v", v, location.String(), err)
+		}
+		w.b.WriteString(number)
+	case []any:
+		if _, err := w.classify(v, location); err != nil {
+			return err
+		}
+		w.b.WriteByte('[')
+		for i, element := range v {
+			if i > 0 {
+				w.b.WriteString(", ")
+			}
+			if err := w.writeValue(element, location.child(strconv.Itoa(i)), false); err != nil {
+				return err
+			}
+		}
+		w.b.WriteByte(']')
+	case *jsonObject:
+		if kind := w.datetimeKind(v); kind != "" {
+			text, _ := v.Get("value")
+			if _, err := time.Parse(tomlDatetimeLayouts[kind], text.(string)); err != nil {
+				return fmt.Errorf("invalid %s value %q at %q", kind, text, location.String())
+			}
+			w.b.WriteString(text.(string))
+			return nil
+		}
+		if v.Len() == 0 {
+			w.b.WriteString("{}")
+			return nil
+		}
+		w.b.WriteString("{ ")
+		for i, key := range v.Keys() {
+			if i > 0 {
+				w.b.WriteString(", ")
+			}
+			member, _ := v.Get(key)
+			w.b.WriteString(tomlKey(key))
+			w.b.WriteString(" = ")
+			if err := w.writeValue(member, location.child(key), false); err != nil {
+				return err
+			}
+		}
+		w.b.WriteString(" }")
+	}
+	return nil
+}
+
+// tomlNumber converts a JSON number to a TOML integer or float.
+func tomlNumber(n json.Number) (string, error) {
+	text := n.String()
+	if !strings.ContainsAny(text, ".eE") {
+		if _, err := strconv.ParseInt(text, 10, 64); err != nil {
+			return "", errors.New("is out of the range of TOML integers")
+		}
+		return text, nil
+	}
+	if _, err := strconv.ParseFloat(text, 64); err != nil {
+		return "", errors.New("is out of the range of TOML floats")
+	}
+	return text, nil
+}
+
+// tomlKey returns a key, quoted unless it is a bare key.
+func tomlKey(key string) string {
+	if tomlBareKeyPattern.MatchString(key) {
+		return key
+	}
+	return tomlString(key)
+}
+
+// tomlKeyPath returns the dotted key of a table header.
+func tomlKeyPath(path []string) string {
+	keys := make([]string, len(path))
+	for i, key := range path {
+		keys[i] = tomlKey(key)
+	}
+	return strings.Join(keys, ".")
+}
+
+// tomlString returns a basic string.
+func tomlString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			writeTOMLRune(&b, r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// tomlMultilineString returns a multi-line basic string. The newline after
+// the opening delimiter is not part of the value.
+func tomlMultilineString(s string) string {
+	var b strings.Builder
+	b.WriteString("\"\"\"\n")
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n', '\t':
+			b.WriteRune(r)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			writeTOMLRune(&b, r)
+		}
+	}
+	b.WriteString(`"""`)
+	return b.String()
+}
+
+// writeTOMLRune writes a rune, escaping control characters.
+func writeTOMLRune(b *strings.Builder, r rune) {
+	if r < 0x20 || r == 0x7f {
+		fmt.Fprintf(b, `\u%04X`, r)
+		return
+	}
+	b.WriteRune(r)
+}
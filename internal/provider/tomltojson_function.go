@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = TOMLToJSONFunction{}
+)
+
+func NewTOMLToJSONFunction() function.Function {
+	return TOMLToJSONFunction{}
+}
+
+type TOMLToJSONFunction struct{}
+
+func (r TOMLToJSONFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "tomltojson")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "tomltojson"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r TOMLToJSONFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "tomltojson")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert TOML to pretty-printed JSON",
+		MarkdownDescription: `Converts a TOML document to pretty-printed JSON, preserving key order.
+
+## Conversion
+
+- Tables and inline tables become objects, and arrays of tables (` + "`[[servers]]`" + `) become arrays of objects.
+- Integers, including hexadecimal, octal and binary ones, become decimal JSON numbers. Floats become JSON numbers; ` + "`inf`" + ` and ` + "`nan`" + ` cannot be represented in JSON and are rejected.
+- Offset datetimes become RFC 3339 strings such as ` + "`\"1979-05-27T07:32:00Z\"`" + `. Local datetimes, dates and times become strings without an offset, such as ` + "`\"1979-05-27T07:32:00\"`" + `, ` + "`\"1979-05-27\"`" + ` and ` + "`\"07:32:00\"`" + `.
+
+## Tagged Datetimes
+
+Datetimes are indistinguishable from strings once converted. With ` + "`tag_datetimes`" + `, each datetime becomes an object with its type and value, as in the [toml-test](https://github.com/toml-lang/toml-test) JSON encoding: ` + "`{\"type\": \"datetime\", \"value\": \"1979-05-27T07:32:00Z\"}`" + `. The types are ` + "`datetime`" + `, ` + "`datetime-local`" + `, ` + "`date-local`" + ` and ` + "`time-local`" + `. ` + "`jsontotoml`" + ` with the same option writes such objects back as datetimes.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "toml",
+				MarkdownDescription: "The TOML document to convert.\n\n**Requirements:**\n- Must be valid TOML syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `tag_datetimes` - Convert datetimes to objects with `type` and `value` instead of strings (default `false`)\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ tag_datetimes = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r TOMLToJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "tomltojson")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting TOML to JSON function execution")
+
+	var tomlString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &tomlString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "tag_datetimes", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	tagDatetimes, funcErr := opts.boolOption("tag_datetimes", false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if funcErr := validateInputSize(ctx, tomlString, "TOML", 0); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, err := parseTOMLDocument(tomlString, tagDatetimes)
+	if err != nil {
+		tflog.Error(ctx, "TOML parsing failed", map[string]any{
+			"error_type":    ErrorTypeParsing,
+			"error_code":    "TOML_PARSE_ERROR",
+			"error":         err.Error(),
+			"input_preview": truncateString(tomlString, 100),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("TOML parsing error: %s.", strings.TrimPrefix(err.Error(), "toml: ")))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "TOML to JSON function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
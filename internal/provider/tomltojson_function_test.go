@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting TOML to JSON.
+func TestTOMLToJSONFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_tables" {
+					value = provider::prettyjson::tomltojson(<<-EOT
+						title = "agent"
+						[owner]
+						name = "ops"
+						[database]
+						ports = [8000, 8001]
+						enabled = true
+						limits = { max = 0x10, ratio = 1.0 }
+						EOT
+					)
+				}
+				output "test_table_arrays" {
+					value = jsonencode(jsondecode(provider::prettyjson::tomltojson(<<-EOT
+						[[inputs.cpu]]
+						percpu = true
+						totalcpu = false
+						[[inputs.cpu]]
+						percpu = false
+						[inputs.cpu.tags]
+						role = "db"
+						[[inputs.disk]]
+						mount_points = ["/"]
+						EOT
+					)))
+				}
+				output "test_datetimes" {
+					value = jsonencode(jsondecode(provider::prettyjson::tomltojson(<<-EOT
+						offset = 1979-05-27T00:32:00.5-07:00
+						utc = 1979-05-27 07:32:00Z
+						local = 1979-05-27T07:32:00
+						date = 1979-05-27
+						time = 07:32:00
+						EOT
+					)))
+				}
+				output "test_floats" {
+					value = provider::prettyjson::tomltojson("whole = 100000000.0\nfraction = 1234567.5\nsmall = 5e-7\nlarge = 1e21\n")
+				}
+				output "test_tagged" {
+					value = jsonencode(jsondecode(provider::prettyjson::tomltojson("date = 1979-05-27\n", { tag_datetimes = true })))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_tables", "{\n  \"title\": \"agent\",\n  \"owner\": {\n    \"name\": \"ops\"\n  },\n  \"database\": {\n    \"ports\": [\n      8000,\n      8001\n    ],\n    \"enabled\": true,\n    \"limits\": {\n      \"max\": 16,\n      \"ratio\": 1.0\n    }\n  }\n}"),
+					resource.TestCheckOutput("test_table_arrays", `{"inputs":{"cpu":[{"percpu":true,"totalcpu":false},{"percpu":false,"tags":{"role":"db"}}],"disk":[{"mount_points":["/"]}]}}`),
+					resource.TestCheckOutput("test_datetimes", `{"date":"1979-05-27","local":"1979-05-27T07:32:00","offset":"1979-05-27T00:32:00.5-07:00","time":"07:32:00","utc":"1979-05-27T07:32:00Z"}`),
+					resource.TestCheckOutput("test_floats", "{\n  \"whole\": 100000000.0,\n  \"fraction\": 1234567.5,\n  \"small\": 5e-7,\n  \"large\": 1e+21\n}"),
+					resource.TestCheckOutput("test_tagged", `{"date":{"type":"date-local","value":"1979-05-27"}}`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestTOMLToJSONFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_syntax_error" {
+					value = provider::prettyjson::tomltojson("a = 1\nb = \n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`TOML\s+parsing\s+error:\s+line\s+\d+`),
+			},
+			{
+				Config: `
+				output "test_duplicate_key" {
+					value = provider::prettyjson::tomltojson("a = 1\na = 2\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`TOML\s+parsing\s+error:[\s\S]*already\s+been\s+defined`),
+			},
+			{
+				Config: `
+				output "test_infinity" {
+					value = provider::prettyjson::tomltojson("[limits]\nmax = inf\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`value\s+\+Inf\s+at\s+"/limits/max"\s+cannot\s+be\s+represented\s+in\s+JSON`),
+			},
+			{
+				Config: `
+				output "test_empty" {
+					value = provider::prettyjson::tomltojson("")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`TOML\s+input\s+cannot\s+be\s+empty`),
+			},
+		},
+	})
+}
@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Default conventions for representing XML in JSON.
+const (
+	DefaultXMLAttributePrefix = "@"
+	DefaultXMLTextKey         = "#text"
+)
+
+// Namespace handling modes of xmltojson.
+const (
+	XMLNamespacesKeep  = "keep"
+	XMLNamespacesStrip = "strip"
+)
+
+// xmlDeclaration is written at the start of generated XML documents.
+const xmlDeclaration = `<?xml version="1.0" encoding="UTF-8"?>`
+
+// xmlNamePattern matches element and attribute names, including a namespace
+// prefix.
+var xmlNamePattern = regexp.MustCompile(`^[\p{L}_:][\p{L}\p{N}_.:\-]*$`)
+
+// xmlConventionsDescription documents how XML is represented in JSON by
+// xmltojson and jsontoxml.
+const xmlConventionsDescription = `## Conventions
+
+An element becomes a member named after the element:
+
+- An element with only text becomes a string, and an empty element becomes ` + "`null`" + `.
+- Attributes become members named with the attribute prefix, ` + "`\"@id\"`" + ` by default, and are always strings.
+- The text of an element with attributes or child elements becomes a member named with the text key, ` + "`\"#text\"`" + ` by default.
+- Repeated child elements become an array. Elements listed in ` + "`always_array`" + ` are arrays even when they occur once, so that the shape of the result does not depend on the number of elements.
+
+For example, ` + "`<server id=\"a\"><port>80</port><port>443</port></server>`" + ` becomes ` + "`{\"server\": {\"@id\": \"a\", \"port\": [\"80\", \"443\"]}}`" + `.`
+
+// xmlConventions are the names used to represent attributes and text.
+type xmlConventions struct {
+	attributePrefix string
+	textKey         string
+}
+
+// parseXMLConventions reads the attribute_prefix and text_key options.
+func parseXMLConventions(opts functionOptions) (xmlConventions, *function.FuncError) {
+	var conventions xmlConventions
+	var funcErr *function.FuncError
+
+	for _, option := range []struct {
+		name  string
+		value *string
+		def   string
+	}{
+		{"attribute_prefix", &conventions.attributePrefix, DefaultXMLAttributePrefix},
+		{"text_key", &conventions.textKey, DefaultXMLTextKey},
+	} {
+		if *option.value, funcErr = opts.stringOption(option.name, option.def); funcErr != nil {
+			return conventions, funcErr
+		}
+		if *option.value == "" {
+			return conventions, function.NewArgumentFuncError(opts.argumentPosition, fmt.Sprintf("Option %q cannot be empty.", option.name))
+		}
+	}
+	return conventions, nil
+}
+
+// xmlParser converts an XML document into the document model.
+type xmlParser struct {
+	xmlConventions
+	alwaysArray     []string
+	stripNamespaces bool
+}
+
+// xmlElement is an element whose end tag has not been read yet.
+type xmlElement struct {
+	name        xml.Name
+	object      *jsonObject
+	text        strings.Builder
+	hasChildren bool
+}
+
+// parse converts an XML document into an object with a single member named
+// after the root element. Comments, processing instructions and the
+// document type declaration are ignored.
+func (p xmlParser) parse(data string) (*jsonObject, error) {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+	var stack []*xmlElement
+	var root *jsonObject
+
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				return nil, fmt.Errorf("line %d: %s", syntaxErr.Line, syntaxErr.Msg)
+			}
+			return nil, err
+		}
+		line, _ := decoder.InputPos()
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if len(stack) == 0 && root != nil {
+				return nil, fmt.Errorf("line %d: the document has more than one root element", line)
+			}
+			element := &xmlElement{name: t.Name, object: newJSONObject()}
+			for _, attr := range t.Attr {
+				isDeclaration := attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns")
+				if isDeclaration && p.stripNamespaces {
+					continue
+				}
+				name := p.name(attr.Name)
+				if _, exists := element.object.Get(p.attributePrefix + name); exists {
+					return nil, fmt.Errorf("line %d: element <%s> has more than one attribute named %q", line, xmlQualifiedName(t.Name), name)
+				}
+				element.object.Set(p.attributePrefix+name, attr.Value)
+			}
+			if len(stack) > 0 {
+				stack[len(stack)-1].hasChildren = true
+			}
+			stack = append(stack, element)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: unexpected end tag </%s>", line, xmlQualifiedName(t.Name))
+			}
+			element := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if element.name != t.Name {
+				return nil, fmt.Errorf("line %d: element <%s> closed by </%s>", line, xmlQualifiedName(element.name), xmlQualifiedName(t.Name))
+			}
+			name, value := p.name(element.name), p.value(element)
+			if len(stack) == 0 {
+				root = newJSONObject()
+				root.Set(name, value)
+			} else {
+				p.addChild(stack[len(stack)-1].object, name, value)
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			} else if strings.TrimSpace(string(t)) != "" {
+				return nil, fmt.Errorf("line %d: text outside the root element", line)
+			}
+		}
+	}
+
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("element <%s> is not closed", xmlQualifiedName(stack[len(stack)-1].name))
+	}
+	if root == nil {
+		return nil, errors.New("the document has no root element")
+	}
+	return root, nil
+}
+
+// name returns the member name of an element or attribute name.
+func (p xmlParser) name(name xml.Name) string {
+	if p.stripNamespaces {
+		return name.Local
+	}
+	return xmlQualifiedName(name)
+}
+
+// xmlQualifiedName returns a name with its prefix as written.
+func xmlQualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// value returns the value of a closed element. Whitespace between child
+// elements is ignored, and the text of mixed content is trimmed.
+func (p xmlParser) value(element *xmlElement) any {
+	text := element.text.String()
+	if strings.TrimSpace(text) == "" {
+		text = ""
+	} else if element.hasChildren {
+		text = strings.TrimSpace(text)
+	}
+
+	if element.object.Len() == 0 && !element.hasChildren {
+		if text == "" {
+			return nil
+		}
+		return text
+	}
+	if text != "" {
+		element.object.Set(p.textKey, text)
+	}
+	return element.object
+}
+
+// addChild adds a child element to its parent, collecting repeated elements
+// into an array.
+func (p xmlParser) addChild(parent *jsonObject, name string, value any) {
+	existing, ok := parent.Get(name)
+	switch {
+	case !ok && slices.Contains(p.alwaysArray, name):
+		parent.Set(name, []any{value})
+	case !ok:
+		parent.Set(name, value)
+	default:
+		if array, isArray := existing.([]any); isArray {
+			parent.Set(name, append(array, value))
+		} else {
+			parent.Set(name, []any{existing, value})
+		}
+	}
+}
+
+// xmlWriter writes a document as indented XML.
+type xmlWriter struct {
+	xmlConventions
+	b      strings.Builder
+	indent string
+}
+
+// xmlAttribute is an attribute of a generated element.
+type xmlAttribute struct {
+	name, value string
+}
+
+// formatXMLDocument writes a document as XML. Without a root name, the
+// document must be an object with a single member, which is the root
+// element. xmlns lists namespace declarations added to the root element.
+func formatXMLDocument(document any, conventions xmlConventions, root string, xmlns []xmlAttribute, indent string, declaration bool) (string, error) {
+	value, location := document, jsonPointer(nil)
+	if root == "" {
+		object, ok := document.(*jsonObject)
+		if !ok || object.Len() != 1 {
+			return "", errors.New("the document must be an object with a single member, the root element, unless the root option is set")
+		}
+		root = object.Keys()[0]
+		value, _ = object.Get(root)
+		location = location.child(root)
+	}
+	if _, isArray := value.([]any); isArray {
+		return "", fmt.Errorf("the root element %q cannot be an array", root)
+	}
+
+	w := &xmlWriter{xmlConventions: conventions, indent: indent}
+	if declaration {
+		w.b.WriteString(xmlDeclaration)
+		w.b.WriteByte('\n')
+	}
+	if err := w.writeElement(root, value, location, 0, xmlns); err != nil {
+		return "", err
+	}
+	return w.b.String(), nil
+}
+
+// writeElement writes an element and its children at the given depth.
+func (w *xmlWriter) writeElement(name string, value any, location jsonPointer, depth int, attributes []xmlAttribute) error {
+	if !xmlNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid element name %q at %q", name, location.String())
+	}
+
+	var text *string
+	var children []string
+	switch v := value.(type) {
+	case *jsonObject:
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			memberLocation := location.child(key)
+			switch {
+			case key == w.textKey:
+				s, err := xmlScalarText(member, memberLocation)
+				if err != nil {
+					return err
+				}
+				text = &s
+			case strings.HasPrefix(key, w.attributePrefix):
+				attributeName := strings.TrimPrefix(key, w.attributePrefix)
+				if !xmlNamePattern.MatchString(attributeName) {
+					return fmt.Errorf("invalid attribute name %q at %q", attributeName, memberLocation.String())
+				}
+				if member == nil {
+					continue
+				}
+				s, err := xmlScalarText(member, memberLocation)
+				if err != nil {
+					return err
+				}
+				attributes = append(attributes, xmlAttribute{attributeName, s})
+			default:
+				children = append(children, key)
+			}
+		}
+	case []any:
+		return fmt.Errorf("nested array at %q cannot be represented in XML", location.String())
+	case nil:
+	default:
+		s, err := xmlScalarText(v, location)
+		if err != nil {
+			return err
+		}
+		text = &s
+	}
+
+	w.writeIndent(depth)
+	w.b.WriteByte('<')
+	w.b.WriteString(name)
+	for _, attribute := range attributes {
+		fmt.Fprintf(&w.b, " %s=\"%s\"", attribute.name, xmlEscape(attribute.value, true))
+	}
+	if len(children) == 0 && (text == nil || *text == "") {
+		w.b.WriteString("/>\n")
+		return nil
+	}
+	w.b.WriteByte('>')
+	if text != nil {
+		w.b.WriteString(xmlEscape(*text, false))
+	}
+	if len(children) == 0 {
+		fmt.Fprintf(&w.b, "</%s>\n", name)
+		return nil
+	}
+	w.b.WriteByte('\n')
+
+	object := value.(*jsonObject)
+	for _, key := range children {
+		member, _ := object.Get(key)
+		elements, isArray := member.([]any)
+		if !isArray {
+			if err := w.writeElement(key, member, location.child(key), depth+1, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		for i, element := range elements {
+			if err := w.writeElement(key, element, location.child(key).child(strconv.Itoa(i)), depth+1, nil); err != nil {
+				return err
+			}
+		}
+	}
+	w.writeIndent(depth)
+	fmt.Fprintf(&w.b, "</%s>\n", name)
+	return nil
+}
+
+func (w *xmlWriter) writeIndent(depth int) {
+	w.b.WriteString(strings.Repeat(w.indent, depth))
+}
+
+// xmlScalarText returns the text of a scalar and checks that it only holds
+// characters allowed in XML.
+func xmlScalarText(value any, location jsonPointer) (string, error) {
+	var text string
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		text = v
+	case bool:
+		text = strconv.FormatBool(v)
+	case json.Number:
+		text = v.String()
+	default:
+		return "", fmt.Errorf("%s at %q must be a string, number or boolean", jsonTypeName(value), location.String())
+	}
+	for _, r := range text {
+		if !isXMLChar(r) {
+			return "", fmt.Errorf("string at %q contains the character U+%04X, which is not allowed in XML", location.String(), r)
+		}
+	}
+	return text, nil
+}
+
+// isXMLChar reports whether r may appear in an XML document.
+func isXMLChar(r rune) bool {
+	return r == '\t' || r == '\n' || r == '\r' ||
+		(r >= 0x20 && r <= 0xD7FF) || (r >= 0xE000 && r <= 0xFFFD) || (r >= 0x10000 && r <= utf8.MaxRune)
+}
+
+// xmlEscape escapes text or attribute values. Whitespace other than spaces is
+// escaped in attribute values so that it survives attribute normalization.
+func xmlEscape(s string, attribute bool) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '&':
+			b.WriteString("&amp;")
+		case r == '<':
+			b.WriteString("&lt;")
+		case r == '>':
+			b.WriteString("&gt;")
+		case r == '"' && attribute:
+			b.WriteString("&quot;")
+		case r == '\r', (r == '\n' || r == '\t') && attribute:
+			fmt.Fprintf(&b, "&#x%X;", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
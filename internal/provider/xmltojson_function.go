@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = XMLToJSONFunction{}
+)
+
+func NewXMLToJSONFunction() function.Function {
+	return XMLToJSONFunction{}
+}
+
+type XMLToJSONFunction struct{}
+
+func (r XMLToJSONFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "xmltojson")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "xmltojson"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r XMLToJSONFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "xmltojson")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert XML to pretty-printed JSON",
+		MarkdownDescription: `Converts an XML document to pretty-printed JSON, preserving the order of attributes and elements.
+
+` + xmlConventionsDescription + `
+
+## Namespaces
+
+By default, names keep their namespace prefix as written, such as ` + "`\"soap:Envelope\"`" + `, and namespace declarations are kept as attributes, such as ` + "`\"@xmlns:soap\"`" + `. With ` + "`namespaces = \"strip\"`" + `, prefixes and namespace declarations are removed, so that ` + "`<soap:Body>`" + ` becomes ` + "`\"Body\"`" + `.
+
+Comments, processing instructions and the document type declaration are ignored. Values are always strings; use ` + "`tonumber`" + ` or ` + "`jsoncel`" + ` where numbers are needed.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "xml",
+				MarkdownDescription: "The XML document to convert.\n\n**Requirements:**\n- Must be well-formed XML\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `attribute_prefix` - Prefix of attribute member names (default `\"@\"`)\n- `text_key` - Member name of element text (default `\"#text\"`)\n- `always_array` - Names of elements that are always arrays\n- `namespaces` - `\"keep\"` (default) or `\"strip\"` namespace prefixes and declarations\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ always_array = [\"port\"], namespaces = \"strip\" }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r XMLToJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "xmltojson")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting XML to JSON function execution")
+
+	var xmlString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &xmlString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "attribute_prefix", "text_key", "always_array", "namespaces", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	parser := xmlParser{}
+	if parser.xmlConventions, funcErr = parseXMLConventions(opts); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if parser.alwaysArray, funcErr = opts.stringListOption("always_array"); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	namespaces, funcErr := opts.stringOption("namespaces", XMLNamespacesKeep, XMLNamespacesKeep, XMLNamespacesStrip)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	parser.stripNamespaces = namespaces == XMLNamespacesStrip
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if funcErr := validateInputSize(ctx, xmlString, "XML", 0); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	document, err := parser.parse(xmlString)
+	if err != nil {
+		tflog.Error(ctx, "XML parsing failed", map[string]any{
+			"error_type":    ErrorTypeParsing,
+			"error_code":    "XML_PARSE_ERROR",
+			"error":         err.Error(),
+			"input_preview": truncateString(xmlString, 100),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("XML parsing error: %v.", err))
+		return
+	}
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "XML to JSON function execution successful", map[string]any{
+		"result_size": len(result),
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for converting XML to JSON.
+func TestXMLToJSONFunction_Convert(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					config = <<-EOT
+						<?xml version="1.0" encoding="UTF-8"?>
+						<!-- appliance configuration -->
+						<config version="2">
+						  <name>edge &amp; core</name>
+						  <server id="a" enabled="true">
+						    <port>80</port>
+						    <port>443</port>
+						  </server>
+						  <server id="b"><port>8080</port></server>
+						  <note lang="en">Keep <b>this</b> short</note>
+						  <empty/>
+						  <script><![CDATA[if a < b then exit]]></script>
+						</config>
+						EOT
+				}
+				output "test_default" {
+					value = provider::prettyjson::xmltojson(local.config)
+				}
+				output "test_conventions" {
+					value = jsonencode(jsondecode(provider::prettyjson::xmltojson(local.config, {
+						attribute_prefix = "_"
+						text_key         = "value"
+						always_array     = ["port", "b"]
+					})).config.server)
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_default", `{
+  "config": {
+    "@version": "2",
+    "name": "edge \u0026 core",
+    "server": [
+      {
+        "@id": "a",
+        "@enabled": "true",
+        "port": [
+          "80",
+          "443"
+        ]
+      },
+      {
+        "@id": "b",
+        "port": "8080"
+      }
+    ],
+    "note": {
+      "@lang": "en",
+      "b": "this",
+      "#text": "Keep  short"
+    },
+    "empty": null,
+    "script": "if a \u003c b then exit"
+  }
+}`),
+					resource.TestCheckOutput("test_conventions", `[{"_enabled":"true","_id":"a","port":["80","443"]},{"_id":"b","port":["8080"]}]`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for namespace handling.
+func TestXMLToJSONFunction_Namespaces(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					envelope = "<soap:Envelope xmlns:soap=\"http://schemas.xmlsoap.org/soap/envelope/\"><soap:Body><m:GetPrice xmlns:m=\"urn:prices\" m:currency=\"EUR\"><m:Item>Apples</m:Item></m:GetPrice></soap:Body></soap:Envelope>"
+				}
+				output "test_keep" {
+					value = jsonencode(jsondecode(provider::prettyjson::xmltojson(local.envelope)))
+				}
+				output "test_strip" {
+					value = jsonencode(jsondecode(provider::prettyjson::xmltojson(local.envelope, { namespaces = "strip" })))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_keep", `{"soap:Envelope":{"@xmlns:soap":"http://schemas.xmlsoap.org/soap/envelope/","soap:Body":{"m:GetPrice":{"@m:currency":"EUR","@xmlns:m":"urn:prices","m:Item":"Apples"}}}}`),
+					resource.TestCheckOutput("test_strip", `{"Envelope":{"Body":{"GetPrice":{"@currency":"EUR","Item":"Apples"}}}}`),
+				),
+			},
+			{
+				Config: `
+				output "test_mismatched_tag" {
+					value = provider::prettyjson::xmltojson("<a>\n<b></a>")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`XML\s+parsing\s+error:\s+line\s+2:\s+element\s+<b>\s+closed\s+by\s+</a>`),
+			},
+			{
+				Config: `
+				output "test_unclosed" {
+					value = provider::prettyjson::xmltojson("<a><b/>")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`XML\s+parsing\s+error:\s+element\s+<a>\s+is\s+not\s+closed`),
+			},
+			{
+				Config: `
+				output "test_two_roots" {
+					value = provider::prettyjson::xmltojson("<a/><b/>")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`more\s+than\s+one\s+root\s+element`),
+			},
+			{
+				Config: `
+				output "test_invalid_namespaces" {
+					value = provider::prettyjson::xmltojson("<a/>", { namespaces = "resolve" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid\s+value\s+"resolve"\s+for\s+option\s+"namespaces"`),
+			},
+			{
+				Config: `
+				output "test_duplicate_attribute" {
+					value = provider::prettyjson::xmltojson("<a b=\"1\" b=\"2\"/>")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`line\s+1:\s+element\s+<a>\s+has\s+more\s+than\s+one\s+attribute\s+named\s+"b"`),
+			},
+			{
+				Config: `
+				output "test_duplicate_stripped_attribute" {
+					value = provider::prettyjson::xmltojson("<s:B xmlns:s=\"urn:s\" xmlns:y=\"urn:y\">\n<s:C s:x=\"1\" y:x=\"2\"/>\n</s:B>", { namespaces = "strip" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`line\s+2:\s+element\s+<s:C>\s+has\s+more\s+than\s+one\s+attribute\s+named\s+"x"`),
+			},
+		},
+	})
+}
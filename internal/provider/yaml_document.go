@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxYAMLValues limits the number of values a YAML stream may expand to, so
+// that nested aliases cannot inflate a small input into a huge document.
+const MaxYAMLValues = 1_000_000
+
+// DefaultYAMLIndent is the number of spaces per level in generated YAML.
+const DefaultYAMLIndent = 2
+
+// YAML tags of the core schema.
+const (
+	yamlNullTag  = "!!null"
+	yamlBoolTag  = "!!bool"
+	yamlIntTag   = "!!int"
+	yamlFloatTag = "!!float"
+	yamlStrTag   = "!!str"
+	yamlMapTag   = "!!map"
+	yamlSeqTag   = "!!seq"
+	yamlMergeTag = "!!merge"
+)
+
+// Scalar patterns of the YAML 1.2 core schema. Words such as yes, no, on and
+// off are strings, unlike in YAML 1.1.
+var (
+	yamlNullPattern    = regexp.MustCompile(`^(~|null|Null|NULL|)$`)
+	yamlBoolPattern    = regexp.MustCompile(`^(true|True|TRUE|false|False|FALSE)$`)
+	yamlIntPattern     = regexp.MustCompile(`^([-+]?[0-9]+|0o[0-7]+|0x[0-9a-fA-F]+)$`)
+	yamlFloatPattern   = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+	yamlSpecialPattern = regexp.MustCompile(`^([-+]?\.(inf|Inf|INF)|\.(nan|NaN|NAN))$`)
+
+	// YAML 1.1 booleans and base 60 numbers are quoted on output so that
+	// older parsers read them as strings too.
+	yaml11BoolPattern   = regexp.MustCompile(`^(y|Y|yes|Yes|YES|n|N|no|No|NO|on|On|ON|off|Off|OFF)$`)
+	yaml11Base60Pattern = regexp.MustCompile(`^[-+]?[0-9][0-9_]*(:[0-5]?[0-9])+(\.[0-9_]*)?$`)
+)
+
+// yamlCoreTag resolves the tag of a plain scalar with the core schema.
+func yamlCoreTag(value string) string {
+	switch {
+	case yamlNullPattern.MatchString(value):
+		return yamlNullTag
+	case yamlBoolPattern.MatchString(value):
+		return yamlBoolTag
+	case yamlIntPattern.MatchString(value):
+		return yamlIntTag
+	case yamlFloatPattern.MatchString(value), yamlSpecialPattern.MatchString(value):
+		return yamlFloatTag
+	default:
+		return yamlStrTag
+	}
+}
+
+// parseYAMLDocuments decodes every document of a YAML stream into the ordered
+// document model.
+func parseYAMLDocuments(data string) ([]any, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(data))
+	converter := yamlConverter{}
+
+	var documents []any
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
+			return documents, nil
+		} else if err != nil {
+			return nil, err
+		}
+		document, err := converter.convert(&node)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+}
+
+// yamlConverter converts YAML nodes and counts the values it produced.
+type yamlConverter struct {
+	values int
+}
+
+func (c *yamlConverter) convert(node *yaml.Node) (any, error) {
+	c.values++
+	if c.values > MaxYAMLValues {
+		return nil, fmt.Errorf("document expands to more than %d values through aliases", MaxYAMLValues)
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return c.convert(node.Content[0])
+	case yaml.AliasNode:
+		return c.convert(node.Alias)
+	case yaml.ScalarNode:
+		return yamlScalarToJSON(node)
+	case yaml.SequenceNode:
+		if err := checkYAMLCollectionTag(node, yamlSeqTag); err != nil {
+			return nil, err
+		}
+		array := make([]any, 0, len(node.Content))
+		for _, element := range node.Content {
+			value, err := c.convert(element)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		return array, nil
+	case yaml.MappingNode:
+		if err := checkYAMLCollectionTag(node, yamlMapTag); err != nil {
+			return nil, err
+		}
+		return c.convertMapping(node)
+	default:
+		return nil, fmt.Errorf("unsupported YAML node at line %d", node.Line)
+	}
+}
+
+// convertMapping converts a mapping, applying merge keys (<<). Keys defined
+// in the mapping itself take precedence over merged keys, and earlier merged
+// mappings take precedence over later ones.
+func (c *yamlConverter) convertMapping(node *yaml.Node) (*jsonObject, error) {
+	explicit := map[string]bool{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if isYAMLMergeKey(node.Content[i]) {
+			continue
+		}
+		key, err := yamlKeyString(node.Content[i])
+		if err != nil {
+			return nil, err
+		}
+		if explicit[key] {
+			return nil, fmt.Errorf("duplicate key %q at line %d", key, node.Content[i].Line)
+		}
+		explicit[key] = true
+	}
+
+	object := newJSONObject()
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+
+		if isYAMLMergeKey(keyNode) {
+			sources := []*yaml.Node{valueNode}
+			if resolveYAMLAlias(valueNode).Kind == yaml.SequenceNode {
+				sources = resolveYAMLAlias(valueNode).Content
+			}
+			for _, source := range sources {
+				merged, err := c.convert(source)
+				if err != nil {
+					return nil, err
+				}
+				mergedObject, ok := merged.(*jsonObject)
+				if !ok {
+					return nil, fmt.Errorf("merge key at line %d must refer to a mapping or a list of mappings", keyNode.Line)
+				}
+				for _, key := range mergedObject.Keys() {
+					if _, exists := object.Get(key); !exists && !explicit[key] {
+						value, _ := mergedObject.Get(key)
+						object.Set(key, value)
+					}
+				}
+			}
+			continue
+		}
+
+		key, err := yamlKeyString(keyNode)
+		if err != nil {
+			return nil, err
+		}
+		value, err := c.convert(valueNode)
+		if err != nil {
+			return nil, err
+		}
+		object.Set(key, value)
+	}
+	return object, nil
+}
+
+func resolveYAMLAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+func isYAMLMergeKey(node *yaml.Node) bool {
+	node = resolveYAMLAlias(node)
+	return node.Kind == yaml.ScalarNode && node.Value == "<<" &&
+		(node.Style == 0 || (node.Style == yaml.TaggedStyle && node.ShortTag() == yamlMergeTag))
+}
+
+// yamlKeyString converts a mapping key to a JSON member name. Keys must be
+// scalars; numbers, booleans and null keep their literal text.
+func yamlKeyString(node *yaml.Node) (string, error) {
+	node = resolveYAMLAlias(node)
+	if node.Kind != yaml.ScalarNode {
+		return "", fmt.Errorf("mapping key at line %d must be a scalar, JSON only supports string keys", node.Line)
+	}
+	value, err := yamlScalarToJSON(node)
+	if err != nil {
+		return "", err
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "null", nil
+	default:
+		return compactJSONText(v), nil
+	}
+}
+
+func checkYAMLCollectionTag(node *yaml.Node, tag string) error {
+	if node.Style&yaml.TaggedStyle != 0 && node.ShortTag() != tag {
+		return fmt.Errorf("unsupported tag %q at line %d", node.Tag, node.Line)
+	}
+	return nil
+}
+
+// yamlScalarToJSON converts a scalar. Quoted and block scalars are strings,
+// plain scalars are resolved with the core schema and explicit standard tags
+// are honored.
+func yamlScalarToJSON(node *yaml.Node) (any, error) {
+	tag := yamlStrTag
+	switch {
+	case node.Style&yaml.TaggedStyle != 0:
+		tag = node.ShortTag()
+	case node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0:
+		tag = yamlCoreTag(node.Value)
+	}
+
+	value := node.Value
+	switch tag {
+	case yamlStrTag, "!!binary", "!!timestamp":
+		return value, nil
+	case yamlNullTag:
+		if yamlNullPattern.MatchString(value) {
+			return nil, nil
+		}
+	case yamlBoolTag:
+		if yamlBoolPattern.MatchString(value) {
+			return strings.EqualFold(value, "true"), nil
+		}
+	case yamlIntTag:
+		if yamlIntPattern.MatchString(value) {
+			return yamlIntToJSON(value), nil
+		}
+	case yamlFloatTag:
+		if yamlSpecialPattern.MatchString(value) {
+			return nil, fmt.Errorf("value %q at line %d cannot be represented in JSON", value, node.Line)
+		}
+		if yamlIntPattern.MatchString(value) {
+			return yamlIntToJSON(value), nil
+		}
+		if yamlFloatPattern.MatchString(value) {
+			return yamlFloatToJSON(value), nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported tag %q at line %d", node.Tag, node.Line)
+	}
+	return nil, fmt.Errorf("invalid %s value %q at line %d", tag, value, node.Line)
+}
+
+// yamlIntToJSON converts a decimal, octal (0o) or hexadecimal (0x) integer
+// to a decimal JSON number.
+func yamlIntToJSON(value string) json.Number {
+	base := 10
+	digits := value
+	switch {
+	case strings.HasPrefix(value, "0o"):
+		base, digits = 8, value[2:]
+	case strings.HasPrefix(value, "0x"):
+		base, digits = 16, value[2:]
+	}
+	n, _ := new(big.Int).SetString(strings.TrimPrefix(digits, "+"), base)
+	return json.Number(n.String())
+}
+
+// yamlFloatToJSON rewrites a core schema float as a JSON number literal
+// without changing its digits, so 1., .5 and +1e3 become 1, 0.5 and 1e3.
+func yamlFloatToJSON(value string) json.Number {
+	if jsonNumberPattern.MatchString(value) {
+		return json.Number(value)
+	}
+	sign := ""
+	switch value[0] {
+	case '-':
+		sign, value = "-", value[1:]
+	case '+':
+		value = value[1:]
+	}
+	mantissa, exponent := value, ""
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		mantissa, exponent = value[:i], value[i:]
+	}
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	integer = strings.TrimLeft(integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+	if fraction != "" {
+		fraction = "." + fraction
+	}
+	return json.Number(sign + integer + fraction + exponent)
+}
+
+// formatYAMLDocuments renders documents as a YAML stream with the given
+// indentation width. Several documents are separated by "---".
+func formatYAMLDocuments(documents []any, indent int) (string, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(indent)
+	for _, document := range documents {
+		if err := encoder.Encode(jsonToYAMLNode(document)); err != nil {
+			return "", err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// jsonToYAMLNode converts a document value to a YAML node. Strings that a
+// YAML 1.1 or 1.2 parser would read as another type are quoted.
+func jsonToYAMLNode(value any) *yaml.Node {
+	switch v := value.(type) {
+	case *jsonObject:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: yamlMapTag}
+		for _, key := range v.Keys() {
+			member, _ := v.Get(key)
+			node.Content = append(node.Content, yamlStringNode(key), jsonToYAMLNode(member))
+		}
+		return node
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: yamlSeqTag}
+		for _, element := range v {
+			node.Content = append(node.Content, jsonToYAMLNode(element))
+		}
+		return node
+	case string:
+		return yamlStringNode(v)
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: strconv.FormatBool(v)}
+	case json.Number:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: v.String()}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: "null"}
+	}
+}
+
+func yamlStringNode(s string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Tag: yamlStrTag, Value: s}
+	if yamlCoreTag(s) != yamlStrTag || yaml11BoolPattern.MatchString(s) || yaml11Base60Pattern.MatchString(s) {
+		node.Style = yaml.DoubleQuotedStyle
+	}
+	return node
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ function.Function = YAMLToJSONFunction{}
+)
+
+func NewYAMLToJSONFunction() function.Function {
+	return YAMLToJSONFunction{}
+}
+
+type YAMLToJSONFunction struct{}
+
+func (r YAMLToJSONFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "yamltojson")
+	ctx = tflog.SetField(ctx, "operation", "metadata")
+
+	tflog.Debug(ctx, "Starting function metadata operation")
+
+	resp.Name = "yamltojson"
+
+	tflog.Debug(ctx, "Function metadata operation completed", map[string]any{
+		"function_name": resp.Name,
+	})
+}
+
+func (r YAMLToJSONFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "yamltojson")
+	ctx = tflog.SetField(ctx, "operation", "definition")
+
+	tflog.Debug(ctx, "Starting function definition operation")
+
+	resp.Definition = function.Definition{
+		Summary: "Convert YAML to pretty-printed JSON",
+		MarkdownDescription: `Converts a YAML document or stream to pretty-printed JSON. Unlike ` + "`jsonencode(yamldecode(...))`" + `, mapping key order is preserved.
+
+## Conversion
+
+- Plain scalars are resolved with the [YAML 1.2 core schema](https://yaml.org/spec/1.2.2/#103-core-schema): ` + "`true`" + ` and ` + "`false`" + ` are booleans, ` + "`null`" + `, ` + "`~`" + ` and empty values are ` + "`null`" + `, and words such as ` + "`yes`" + `, ` + "`no`" + `, ` + "`on`" + ` and ` + "`off`" + ` stay strings. Quoted and block scalars are always strings.
+- Integers, including octal (` + "`0o17`" + `) and hexadecimal (` + "`0x1F`" + `) ones, become decimal JSON numbers, and other numbers keep their digits. ` + "`.inf`" + ` and ` + "`.nan`" + ` cannot be represented in JSON and are rejected.
+- Anchors and aliases are expanded, and merge keys (` + "`<<: *defaults`" + `) copy the members of the referenced mappings unless the mapping defines them itself.
+- The standard tags ` + "`!!str`" + `, ` + "`!!int`" + `, ` + "`!!float`" + `, ` + "`!!bool`" + ` and ` + "`!!null`" + ` are honored. Other tags, such as CloudFormation's ` + "`!Ref`" + `, are rejected.
+- Mapping keys must be scalars. Duplicate keys are rejected.
+
+## Multiple Documents
+
+A stream with several documents separated by ` + "`---`" + ` is returned as a JSON array with one element per document. A single document is returned as is, unless ` + "`always_array`" + ` is set, so that streams of any length have the same shape.`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "yaml",
+				MarkdownDescription: "The YAML document or stream to convert.\n\n**Requirements:**\n- Must be valid YAML syntax\n- Cannot be empty\n- Maximum size: 10MB",
+				AllowNullValue:      false,
+				AllowUnknownValues:  false,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: "Optional object with options:\n\n- `always_array` - Return an array even when the stream has a single document (default `false`)\n- `indentation_type` - `\"2spaces\"` (default), `\"4spaces\"` or `\"tab\"`\n\n**Example:**\n`{ always_array = true }`",
+			AllowNullValue:      true,
+		},
+		Return: function.StringReturn{},
+	}
+
+	tflog.Debug(ctx, "Function definition operation completed", map[string]any{
+		"parameter_count":    len(resp.Definition.Parameters),
+		"has_variadic_param": resp.Definition.VariadicParameter != nil,
+		"return_type":        "string",
+	})
+}
+
+func (r YAMLToJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx = tflog.SetField(ctx, "function_name", "yamltojson")
+	ctx = tflog.SetField(ctx, "operation", "run")
+
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		tflog.Debug(ctx, "Function execution completed", map[string]any{
+			"duration_ms": duration.Milliseconds(),
+			"duration_ns": duration.Nanoseconds(),
+		})
+	}()
+
+	tflog.Debug(ctx, "Starting YAML to JSON function execution")
+
+	var yamlString string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &yamlString, &options))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to extract function parameters", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	opts, funcErr := parseOptionsArgument(ctx, options, 1, "always_array", "indentation_type")
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	alwaysArray, funcErr := opts.boolOption("always_array", false)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	indent, funcErr := opts.indentation(ctx)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if funcErr := validateInputSize(ctx, yamlString, "YAML", 0); funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	documents, err := parseYAMLDocuments(yamlString)
+	if err != nil {
+		tflog.Error(ctx, "YAML parsing failed", map[string]any{
+			"error_type":    ErrorTypeParsing,
+			"error_code":    "YAML_PARSE_ERROR",
+			"error":         err.Error(),
+			"input_preview": truncateString(yamlString, 100),
+		})
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("YAML parsing error: %s.", strings.TrimPrefix(err.Error(), "yaml: ")))
+		return
+	}
+
+	var document any = documents
+	if len(documents) == 1 && !alwaysArray {
+		document = documents[0]
+	} else if documents == nil {
+		document = []any{}
+	}
+
+	result, funcErr := formatJSONResult(ctx, document, indent)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+	if resp.Error != nil {
+		tflog.Error(ctx, "Failed to set function result", map[string]any{
+			"error": resp.Error.Error(),
+		})
+		return
+	}
+
+	tflog.Info(ctx, "YAML to JSON function execution successful", map[string]any{
+		"result_size":    len(result),
+		"document_count": len(documents),
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Acceptance test for scalar resolution and key order.
+func TestYAMLToJSONFunction_CoreSchema(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_key_order" {
+					value = provider::prettyjson::yamltojson("zone: b\nname: web\nreplicas: 3\n")
+				}
+				output "test_scalars" {
+					value = jsonencode(jsondecode(provider::prettyjson::yamltojson(<<-EOT
+						country: no
+						enabled: on
+						flag: True
+						quoted: "true"
+						empty:
+						tilde: ~
+						octal: 0o17
+						hex: 0x1F
+						leading_zero: 0123
+						float: 1.50
+						short: .5
+						exp: +1e3
+						version: 1.10.2
+						date: 2024-01-01
+						tagged: !!str 42
+						block: |
+						  line1
+						  line2
+						EOT
+					)))
+				}
+				output "test_tab" {
+					value = provider::prettyjson::yamltojson("- a\n- {b: 1}\n", { indentation_type = "tab" })
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_key_order", "{\n  \"zone\": \"b\",\n  \"name\": \"web\",\n  \"replicas\": 3\n}"),
+					resource.TestCheckOutput("test_scalars", `{"block":"line1\nline2\n","country":"no","date":"2024-01-01","empty":null,"enabled":"on","exp":1000,"flag":true,"float":1.5,"hex":31,"leading_zero":123,"octal":15,"quoted":"true","short":0.5,"tagged":"42","tilde":null,"version":"1.10.2"}`),
+					resource.TestCheckOutput("test_tab", "[\n\t\"a\",\n\t{\n\t\t\"b\": 1\n\t}\n]"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for anchors, aliases, merge keys and multiple documents.
+func TestYAMLToJSONFunction_AliasesAndDocuments(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_aliases" {
+					value = jsonencode(jsondecode(provider::prettyjson::yamltojson(<<-EOT
+						defaults: &defaults
+						  cpu: 100m
+						  memory: 128Mi
+						ports: &ports [80, 443]
+						web:
+						  <<: *defaults
+						  memory: 256Mi
+						  ports: *ports
+						EOT
+					)).web)
+				}
+				output "test_merge_order" {
+					value = provider::prettyjson::yamltojson("a: &a {x: 1, y: 1}\nb: &b {y: 2, z: 2}\nc:\n  <<: [*a, *b]\n  w: 0\n")
+				}
+				output "test_multi_document" {
+					value = jsonencode(jsondecode(provider::prettyjson::yamltojson("kind: A\n---\nkind: B\n---\n")))
+				}
+				output "test_always_array" {
+					value = jsonencode(jsondecode(provider::prettyjson::yamltojson("kind: A\n", { always_array = true })))
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_aliases", `{"cpu":"100m","memory":"256Mi","ports":[80,443]}`),
+					resource.TestCheckOutput("test_merge_order", "{\n  \"a\": {\n    \"x\": 1,\n    \"y\": 1\n  },\n  \"b\": {\n    \"y\": 2,\n    \"z\": 2\n  },\n  \"c\": {\n    \"x\": 1,\n    \"y\": 1,\n    \"z\": 2,\n    \"w\": 0\n  }\n}"),
+					resource.TestCheckOutput("test_multi_document", `[{"kind":"A"},{"kind":"B"},null]`),
+					resource.TestCheckOutput("test_always_array", `[{"kind":"A"}]`),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance test for error conditions.
+func TestYAMLToJSONFunction_ErrorConditions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test_syntax_error" {
+					value = provider::prettyjson::yamltojson("a: [1, 2\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`YAML\s+parsing\s+error:\s+line\s+\d+`),
+			},
+			{
+				Config: `
+				output "test_duplicate_key" {
+					value = provider::prettyjson::yamltojson("a: 1\nb: 2\na: 3\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`duplicate\s+key\s+"a"\s+at\s+line\s+3`),
+			},
+			{
+				Config: `
+				output "test_custom_tag" {
+					value = provider::prettyjson::yamltojson("bucket: !Ref Bucket\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unsupported\s+tag\s+"!Ref"\s+at\s+line\s+1`),
+			},
+			{
+				Config: `
+				output "test_infinity" {
+					value = provider::prettyjson::yamltojson("limit: .inf\n")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`value\s+"\.inf"\s+at\s+line\s+1\s+cannot\s+be\s+represented\s+in\s+JSON`),
+			},
+		},
+	})
+}
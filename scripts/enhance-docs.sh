@@ -173,6 +173,42 @@ if [[ -f "$PROVIDER_DOC" ]]; then
 ## Available Functions
 
 - [`jsonprettyprint`](functions/jsonprettyprint.md) - Format JSON strings with configurable indentation
+- [`jsonpatch`](functions/jsonpatch.md) - Apply an RFC 6902 JSON Patch to a JSON document
+- [`jsonmergepatch`](functions/jsonmergepatch.md) - Apply an RFC 7396 JSON Merge Patch to a JSON document
+- [`jsondeepmerge`](functions/jsondeepmerge.md) - Deep merge a list of JSON documents with configurable array strategies
+- [`jsonpointer`](functions/jsonpointer.md) - Read a value from a JSON document by RFC 6901 JSON Pointer
+- [`jsonset`](functions/jsonset.md) - Set a value in a JSON document by JSON Pointer
+- [`jsondelete`](functions/jsondelete.md) - Delete a value from a JSON document by JSON Pointer
+- [`jsonpath`](functions/jsonpath.md) - Query a JSON document with an RFC 9535 JSONPath expression
+- [`jmespath`](functions/jmespath.md) - Query a JSON document with a JMESPath expression
+- [`jq`](functions/jq.md) - Transform a JSON document with a sandboxed jq program
+- [`jsoncel`](functions/jsoncel.md) - Evaluate CEL expressions against a JSON document
+- [`jsonflatten`](functions/jsonflatten.md) - Flatten a JSON document to a map of paths
+- [`jsonunflatten`](functions/jsonunflatten.md) - Rebuild a JSON document from flattened paths
+- [`jsonredact`](functions/jsonredact.md) - Redact sensitive values by path or key pattern
+- [`jsonpick`](functions/jsonpick.md) - Keep only selected paths of a JSON document
+- [`jsonomit`](functions/jsonomit.md) - Remove selected paths from a JSON document
+- [`jsonkeycase`](functions/jsonkeycase.md) - Convert the case of all object keys
+- [`jsonreplace`](functions/jsonreplace.md) - Replace text in string values selected by path
+- [`jsonarraydedupe`](functions/jsonarraydedupe.md) - Remove duplicate elements from JSON arrays
+- [`jsonarrayunion`](functions/jsonarrayunion.md) - Union of JSON arrays
+- [`jsonarrayintersect`](functions/jsonarrayintersect.md) - Intersection of JSON arrays
+- [`jsonarraysubtract`](functions/jsonarraysubtract.md) - Difference of JSON arrays
+- [`jsonsortarrays`](functions/jsonsortarrays.md) - Sort arrays at selected paths
+- [`jsonprune`](functions/jsonprune.md) - Remove null and empty values
+- [`yamltojson`](functions/yamltojson.md) - Convert YAML to JSON with the YAML 1.2 core schema, preserving key order
+- [`jsontoyaml`](functions/jsontoyaml.md) - Convert JSON to YAML with configurable indentation
+- [`tomltojson`](functions/tomltojson.md) - Convert TOML to JSON, preserving key order
+- [`jsontotoml`](functions/jsontotoml.md) - Convert a JSON object to TOML
+- [`xmltojson`](functions/xmltojson.md) - Convert XML to JSON with configurable attribute and text conventions
+- [`jsontoxml`](functions/jsontoxml.md) - Convert JSON to pretty-printed XML
+- [`csvtojson`](functions/csvtojson.md) - Convert CSV to a JSON array of objects with optional type inference
+- [`jsontocsv`](functions/jsontocsv.md) - Convert a JSON array of objects to CSV with flattened nested fields
+- [`jsontoenv`](functions/jsontoenv.md) - Convert a JSON object to a dotenv file
+- [`jsontoini`](functions/jsontoini.md) - Convert a JSON object to an INI file
+- [`jsontoproperties`](functions/jsontoproperties.md) - Convert a JSON object to a Java properties file
+- [`jsontohcl`](functions/jsontohcl.md) - Convert a JSON object to HCL attributes
+- [`hcltojson`](functions/hcltojson.md) - Convert HCL attributes to pretty-printed JSON
 
 ## Use Cases
 